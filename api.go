@@ -0,0 +1,107 @@
+package cielogo
+
+import (
+	"context"
+
+	"github.com/sealtv/cielogo/api/apiv1"
+)
+
+// API is the full Cielo API implemented by Client.
+// Depend on it (or on one of the resource interfaces it embeds) instead of *Client
+// to substitute a fake in tests, see the fake package.
+type API interface {
+	FeedAPI
+	PnLAPI
+	WalletsAPI
+	ListsAPI
+	TrackedWalletsAPI
+	PortfolioAPI
+	TokenAPI
+	WebsocketAPI
+}
+
+// FeedAPI is the transaction feed.
+type FeedAPI interface {
+	GetFeedV1(ctx context.Context, req *apiv1.FeedRequest) (*apiv1.FeedResponse, error)
+}
+
+// PnLAPI covers profit and loss and trading analytics.
+type PnLAPI interface {
+	GetNftsPnlV1(ctx context.Context, req *apiv1.NftsPnLRequest) (*apiv1.NftsPnLResponse, error)
+	GetTokensPnlV1(ctx context.Context, req *apiv1.TokensPnLRequest) (*apiv1.TokensPnLResponse, error)
+	GetAggregatedTokenPnLV1(ctx context.Context, req *apiv1.AggregatedTokenPnLRequest) (*apiv1.AggregatedTokenPnLResponse, error)
+	GetTradingStatsV1(ctx context.Context, req *apiv1.TradingStatsRequest) (*apiv1.TradingStatsResponse, error)
+	StartTradingStatsV1(ctx context.Context, req *apiv1.TradingStatsRequest) (*PendingTradingStats, error)
+}
+
+// WalletsAPI covers related wallets and wallet tags.
+type WalletsAPI interface {
+	GetRelatedWalletsV1(ctx context.Context, req *apiv1.RelatedWalletsRequest) (*apiv1.RelatedWalletsResponse, error)
+	GetWalletTagsV1(ctx context.Context, req *apiv1.GetWalletTagsRequest) (*apiv1.GetWalletTagsResponse, error)
+	GetWalletsTagsV1(ctx context.Context, req *apiv1.GetWalletsTagsRequest) ([]apiv1.WalletTags, error)
+	GetWalletsByTagV1(ctx context.Context, req *apiv1.GetWalletsByTagRequest) (*apiv1.GetWalletsByTagResponse, error)
+}
+
+// ListsAPI covers wallet lists.
+type ListsAPI interface {
+	GetAllWalletsListV1(ctx context.Context, req *apiv1.GetAllWalletsListsRequest) (*apiv1.GetAllWalletsListsResponse, error)
+	GetUserWalletsListsV1(ctx context.Context) ([]apiv1.WalletList, error)
+	AddWalletsListV1(ctx context.Context, req *apiv1.AddWalletsListRequest) (*apiv1.WalletList, error)
+	UpdateWalletsListV1(ctx context.Context, req *apiv1.UpdateWalletsListRequest) (*apiv1.WalletList, error)
+	DeleteWalletsListV1(ctx context.Context, listID int64, deleteWallets bool) error
+	ToggleFollowWalletsListV1(ctx context.Context, listID int64) (*apiv1.ToggleFollowWalletsListResponce, error)
+}
+
+// TrackedWalletsAPI covers tracked wallets and their notification settings.
+type TrackedWalletsAPI interface {
+	GetTrackedWalletsV1(ctx context.Context, req *apiv1.GetTrackedWalletsRequest) (*apiv1.GetTrackedWalletsResponse, error)
+	AddTrackedWalletsV1(ctx context.Context, req *apiv1.AddTrackedWalletRequest) (*apiv1.TrackedWallet, error)
+	RemoveTrackedWalletsV1(ctx context.Context, req *apiv1.RemoveTrackedWalletsRequest) error
+	GetWalletByAddressV1(ctx context.Context, wallet string) (*apiv1.TrackedWallet, error)
+	UpdateTrackedWalletV1(ctx context.Context, walletID int64, req *apiv1.UpdateTrackedWalletRequest) (*apiv1.TrackedWallet, error)
+	UpdateTrackedWalletV2(ctx context.Context, wallet string, req *apiv1.UpdateTrackedWalletV2Request) (*apiv1.TrackedWallet, error)
+	GetTelegramBotsV1(ctx context.Context) (*apiv1.GetTelegramBotsResponse, error)
+}
+
+// PortfolioAPI covers wallet portfolios.
+type PortfolioAPI interface {
+	GetWalletPortfolioV1(ctx context.Context, wallet string) (*apiv1.WalletPortfolioResponse, error)
+	GetWalletPortfolioV2(ctx context.Context, req *apiv1.WalletPortfolioV2Request) (*apiv1.WalletPortfolioV2Response, error)
+}
+
+// TokenAPI covers token information.
+type TokenAPI interface {
+	GetTokenMetadataV1(ctx context.Context, req *apiv1.TokenMetadataRequest) (*apiv1.TokenMetadataResponse, error)
+	GetTokenPriceV1(ctx context.Context, req *apiv1.TokenPriceRequest) (*apiv1.TokenPriceResponse, error)
+	GetTokenStatsV1(ctx context.Context, req *apiv1.TokenStatsRequest) (*apiv1.TokenStatsResponse, error)
+	GetTokenBalanceV1(ctx context.Context, req *apiv1.TokenBalanceRequest) (*apiv1.TokenBalanceResponse, error)
+}
+
+// WebsocketAPI opens WebSocket connections.
+type WebsocketAPI interface {
+	DialWebsocket(ctx context.Context, opts ...WebsocketOption) (WebsocketConn, error)
+}
+
+// WebsocketConn is an open WebSocket connection, implemented by *WebsocketClient.
+type WebsocketConn interface {
+	SendCommand(cmd apiv1.WebSocketsCommand) error
+	SendCommandContext(ctx context.Context, cmd apiv1.WebSocketsCommand) error
+	RunListener(ctx context.Context, out chan<- apiv1.WSEvent) error
+	Close()
+}
+
+var (
+	_ API           = (*Client)(nil)
+	_ WebsocketConn = (*WebsocketClient)(nil)
+)
+
+// DialWebsocket opens a WebSocket connection like NewWebsocketConnection,
+// returning it as a WebsocketConn for code written against the API interface.
+func (c *Client) DialWebsocket(ctx context.Context, opts ...WebsocketOption) (WebsocketConn, error) {
+	ws, err := c.NewWebsocketConnection(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ws, nil
+}
@@ -0,0 +1,273 @@
+// Package address detects, validates and normalizes wallet addresses
+// for the chain families supported by the Cielo API.
+//
+//	addr, err := address.Parse("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+//	// addr.Type == chains.EvmWalletType
+//	// addr.String() == "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+package address
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sealtv/cielogo/api/chains"
+)
+
+// Errors returned by Parse and Validate, wrapped with the offending address.
+var (
+	ErrInvalidAddress = errors.New("cielo: invalid address")
+	ErrChecksum       = errors.New("cielo: invalid address checksum")
+)
+
+const (
+	tronVersion       = 0x41
+	bitcoinP2PKH      = 0x00
+	bitcoinP2SH       = 0x05
+	bitcoinHRP        = "bc"
+	dydxHRP           = "dydx"
+	solanaAddressSize = 32
+)
+
+// Address is a validated wallet address.
+type Address struct {
+	// Value is the normalized address, see Normalize.
+	Value string
+	// Type is the wallet type the address belongs to.
+	Type chains.WalletType
+}
+
+// String returns the normalized address.
+func (a Address) String() string {
+	return a.Value
+}
+
+// Parse detects the wallet type of s, validates it and returns the normalized address.
+// Surrounding whitespace is ignored.
+func Parse(s string) (Address, error) {
+	s = strings.TrimSpace(s)
+
+	typ := Detect(s)
+	if typ == chains.UnknownWalletType {
+		if isEVMShape(s) {
+			return Address{}, fmt.Errorf("%w: %q", ErrChecksum, s)
+		}
+
+		return Address{}, fmt.Errorf("%w: %q", ErrInvalidAddress, s)
+	}
+
+	return Address{Value: normalize(s, typ), Type: typ}, nil
+}
+
+// MustParse is like Parse but panics if s is not a valid address.
+func MustParse(s string) Address {
+	addr, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
+
+// Detect returns the wallet type of a valid address, or chains.UnknownWalletType
+// if s is not a valid address of any supported type.
+func Detect(s string) chains.WalletType {
+	switch {
+	case isEVMShape(s):
+		if validEVM(s) {
+			return chains.EvmWalletType
+		}
+	case validDydx(s):
+		return chains.DydxWalletType
+	case validBitcoin(s):
+		return chains.BitcoinWalletType
+	case validTron(s):
+		return chains.TronWalletType
+	case validSolana(s):
+		return chains.SolanaWalletType
+	}
+
+	return chains.UnknownWalletType
+}
+
+// Validate checks that s is a valid address of the given wallet type.
+func Validate(s string, typ chains.WalletType) error {
+	var ok bool
+	switch typ {
+	case chains.EvmWalletType:
+		if isEVMShape(s) && !validEVM(s) {
+			return fmt.Errorf("%w: %q", ErrChecksum, s)
+		}
+		ok = isEVMShape(s)
+	case chains.SolanaWalletType:
+		ok = validSolana(s)
+	case chains.TronWalletType:
+		ok = validTron(s)
+	case chains.BitcoinWalletType:
+		ok = validBitcoin(s)
+	case chains.DydxWalletType:
+		ok = validDydx(s)
+	default:
+		return fmt.Errorf("%w: unsupported wallet type %q", ErrInvalidAddress, typ)
+	}
+
+	if !ok {
+		return fmt.Errorf("%w: %q is not a %s address", ErrInvalidAddress, s, typ)
+	}
+
+	return nil
+}
+
+// Valid reports whether s is a valid address of any supported wallet type.
+func Valid(s string) bool {
+	return Detect(s) != chains.UnknownWalletType
+}
+
+// Normalize returns the canonical form of s, suitable for cache and dedup keys:
+// EVM addresses and bech32 addresses are lowercased, base58 addresses are
+// case-sensitive and kept as is. Invalid addresses are returned trimmed but otherwise unchanged.
+func Normalize(s string) string {
+	s = strings.TrimSpace(s)
+
+	return normalize(s, Detect(s))
+}
+
+// Equal reports whether a and b are the same address once normalized.
+func Equal(a, b string) bool {
+	return Normalize(a) == Normalize(b)
+}
+
+// ChecksumEVM returns the EIP-55 mixed-case form of an EVM address.
+func ChecksumEVM(s string) (string, error) {
+	if !isEVMShape(s) {
+		return "", fmt.Errorf("%w: %q is not an evm address", ErrInvalidAddress, s)
+	}
+
+	return eip55(s[2:]), nil
+}
+
+func normalize(s string, typ chains.WalletType) string {
+	switch typ {
+	case chains.EvmWalletType:
+		return "0x" + strings.ToLower(s[2:])
+	case chains.DydxWalletType:
+		return strings.ToLower(s)
+	case chains.BitcoinWalletType:
+		if strings.HasPrefix(strings.ToLower(s), bitcoinHRP+"1") {
+			return strings.ToLower(s)
+		}
+	}
+
+	return s
+}
+
+// isEVMShape reports whether s is "0x" followed by 40 hex digits, ignoring the checksum.
+func isEVMShape(s string) bool {
+	if len(s) != 42 || (s[:2] != "0x" && s[:2] != "0X") {
+		return false
+	}
+
+	_, err := hex.DecodeString(s[2:])
+
+	return err == nil
+}
+
+// validEVM reports whether an EVM-shaped address passes EIP-55. All-lowercase and
+// all-uppercase addresses carry no checksum and are always accepted.
+func validEVM(s string) bool {
+	digits := s[2:]
+	if digits == strings.ToLower(digits) || digits == strings.ToUpper(digits) {
+		return true
+	}
+
+	return eip55(digits) == "0x"+digits
+}
+
+func eip55(digits string) string {
+	lower := strings.ToLower(digits)
+	hash := keccak256([]byte(lower))
+
+	out := []byte(lower)
+	for i, c := range out {
+		if c < 'a' {
+			continue
+		}
+
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if nibble >= 8 {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+
+	return "0x" + string(out)
+}
+
+func validSolana(s string) bool {
+	if len(s) < 32 || len(s) > 44 {
+		return false
+	}
+
+	decoded, ok := base58Decode(s)
+
+	return ok && len(decoded) == solanaAddressSize
+}
+
+func validTron(s string) bool {
+	if len(s) != 34 || s[0] != 'T' {
+		return false
+	}
+
+	payload, ok := base58CheckDecode(s)
+
+	return ok && len(payload) == 21 && payload[0] == tronVersion
+}
+
+func validBitcoin(s string) bool {
+	if strings.HasPrefix(strings.ToLower(s), bitcoinHRP+"1") {
+		return validSegwit(s)
+	}
+
+	if len(s) < 26 || len(s) > 35 {
+		return false
+	}
+
+	payload, ok := base58CheckDecode(s)
+
+	return ok && len(payload) == 21 && (payload[0] == bitcoinP2PKH || payload[0] == bitcoinP2SH)
+}
+
+// validSegwit validates a BIP-173/BIP-350 segwit address on Bitcoin mainnet.
+func validSegwit(s string) bool {
+	hrp, data, constant, ok := bech32Decode(s)
+	if !ok || hrp != bitcoinHRP || len(data) < 1 {
+		return false
+	}
+
+	version := data[0]
+	program, ok := convertBits5to8(data[1:])
+	if !ok || version > 16 || len(program) < 2 || len(program) > 40 {
+		return false
+	}
+
+	if version == 0 {
+		return constant == bech32Const && (len(program) == 20 || len(program) == 32)
+	}
+
+	return constant == bech32mConst
+}
+
+// validDydx validates a dYdX chain (Cosmos SDK) account address.
+func validDydx(s string) bool {
+	hrp, data, constant, ok := bech32Decode(s)
+	if !ok || hrp != dydxHRP || constant != bech32Const {
+		return false
+	}
+
+	program, ok := convertBits5to8(data)
+
+	return ok && (len(program) == 20 || len(program) == 32)
+}
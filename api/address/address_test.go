@@ -0,0 +1,99 @@
+package address_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sealtv/cielogo/api/address"
+	"github.com/sealtv/cielogo/api/chains"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		want    chains.WalletType
+	}{
+		{"evm checksummed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", chains.EvmWalletType},
+		{"evm lowercase", "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359", chains.EvmWalletType},
+		{"evm uppercase", "0xDBF03B407C01E7CD3CBEA99509D93F8DDDC8C6FB", chains.EvmWalletType},
+		{"evm bad checksum", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", chains.UnknownWalletType},
+		{"evm short", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", chains.UnknownWalletType},
+		{"solana", "So11111111111111111111111111111111111111112", chains.SolanaWalletType},
+		{"solana system program", "11111111111111111111111111111111", chains.SolanaWalletType},
+		{"solana invalid character", "So1111111111111111111111111111111111111111O", chains.UnknownWalletType},
+		{"tron", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", chains.TronWalletType},
+		{"tron bad checksum", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", chains.UnknownWalletType},
+		{"bitcoin p2pkh", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", chains.BitcoinWalletType},
+		{"bitcoin p2sh", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", chains.BitcoinWalletType},
+		{"bitcoin segwit", "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq", chains.BitcoinWalletType},
+		{"bitcoin segwit uppercase", "BC1QAR0SRRR7XFKVY5L643LYDNW9RE59GTZZWF5MDQ", chains.BitcoinWalletType},
+		{"bitcoin taproot", "bc1p5d7rjq7g6rdk2yhzks9smlaqtedr4dekq08ge8ztwac72sfr9rusxg3297", chains.BitcoinWalletType},
+		{"bitcoin segwit bad checksum", "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdp", chains.UnknownWalletType},
+		{"bitcoin segwit mixed case", "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mDQ", chains.UnknownWalletType},
+		{"dydx", "dydx1ehqt0q2fgm2qw2ghjrpr4zz63nvzlpzmy55lux", chains.DydxWalletType},
+		{"dydx bad checksum", "dydx1ehqt0q2fgm2qw2ghjrpr4zz63nvzlpzmy55luy", chains.UnknownWalletType},
+		{"empty", "", chains.UnknownWalletType},
+		{"garbage", "not-an-address", chains.UnknownWalletType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, address.Detect(tt.address))
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	addr, err := address.Parse(" 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed ")
+	require.NoError(t, err)
+	assert.Equal(t, chains.EvmWalletType, addr.Type)
+	assert.Equal(t, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", addr.String())
+
+	addr, err = address.Parse("BC1QAR0SRRR7XFKVY5L643LYDNW9RE59GTZZWF5MDQ")
+	require.NoError(t, err)
+	assert.Equal(t, "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq", addr.Value)
+
+	addr, err = address.Parse("So11111111111111111111111111111111111111112")
+	require.NoError(t, err)
+	assert.Equal(t, "So11111111111111111111111111111111111111112", addr.Value)
+
+	_, err = address.Parse("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD")
+	assert.True(t, errors.Is(err, address.ErrChecksum))
+
+	_, err = address.Parse("nope")
+	assert.True(t, errors.Is(err, address.ErrInvalidAddress))
+
+	assert.Panics(t, func() { address.MustParse("nope") })
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, address.Validate("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", chains.TronWalletType))
+	assert.ErrorIs(t, address.Validate("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", chains.SolanaWalletType), address.ErrInvalidAddress)
+	assert.ErrorIs(t, address.Validate("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", chains.EvmWalletType), address.ErrChecksum)
+	assert.ErrorIs(t, address.Validate("0x1", chains.UnknownWalletType), address.ErrInvalidAddress)
+}
+
+func TestNormalize(t *testing.T) {
+	assert.True(t, address.Equal("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"))
+	assert.False(t, address.Equal("So11111111111111111111111111111111111111112", "so11111111111111111111111111111111111111112"))
+	assert.Equal(t, "nope", address.Normalize(" nope "))
+}
+
+func TestChecksumEVM(t *testing.T) {
+	for _, want := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	} {
+		got, err := address.ChecksumEVM(address.Normalize(want))
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	_, err := address.ChecksumEVM("0x1")
+	assert.ErrorIs(t, err, address.ErrInvalidAddress)
+}
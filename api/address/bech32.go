@@ -0,0 +1,98 @@
+package address
+
+import "strings"
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+const (
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+// bech32Decode decodes a bech32 or bech32m string into its human-readable part,
+// its 5-bit data values without the checksum and the checksum constant it matched.
+// Mixed-case strings are rejected, as required by BIP-173.
+func bech32Decode(s string) (hrp string, data []byte, constant uint32, ok bool) {
+	if len(s) > 90 || (strings.ToLower(s) != s && strings.ToUpper(s) != s) {
+		return "", nil, 0, false
+	}
+	s = strings.ToLower(s)
+
+	sep := strings.LastIndexByte(s, '1')
+	if sep < 1 || sep+7 > len(s) {
+		return "", nil, 0, false
+	}
+
+	hrp = s[:sep]
+	for _, c := range hrp {
+		if c < 33 || c > 126 {
+			return "", nil, 0, false
+		}
+	}
+
+	values := make([]byte, 0, len(s)-sep-1)
+	for _, c := range s[sep+1:] {
+		i := strings.IndexRune(bech32Charset, c)
+		if i < 0 {
+			return "", nil, 0, false
+		}
+		values = append(values, byte(i))
+	}
+
+	constant = bech32Polymod(append(bech32HRPExpand(hrp), values...))
+	if constant != bech32Const && constant != bech32mConst {
+		return "", nil, 0, false
+	}
+
+	return hrp, values[:len(values)-6], constant, true
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+
+	return chk
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	out := make([]byte, 0, len(hrp)*2+1)
+	for _, c := range hrp {
+		out = append(out, byte(c>>5))
+	}
+	out = append(out, 0)
+	for _, c := range hrp {
+		out = append(out, byte(c&31))
+	}
+
+	return out
+}
+
+// convertBits5to8 regroups 5-bit values into bytes, rejecting non-zero padding.
+func convertBits5to8(data []byte) ([]byte, bool) {
+	var acc, bitCount uint
+	out := make([]byte, 0, len(data)*5/8)
+	for _, v := range data {
+		acc = acc<<5 | uint(v)
+		bitCount += 5
+		for bitCount >= 8 {
+			bitCount -= 8
+			out = append(out, byte(acc>>bitCount))
+		}
+	}
+
+	if bitCount >= 5 || acc&(1<<bitCount-1) != 0 {
+		return nil, false
+	}
+
+	return out, true
+}
@@ -1,10 +1,7 @@
 package apiv1
 
 import (
-	"net/url"
-	"strconv"
-	"strings"
-
+	"github.com/sealtv/cielogo/api"
 	"github.com/sealtv/cielogo/api/chains"
 )
 
@@ -16,36 +13,34 @@ const (
 	AggregatedTokenPnLTimeframe30Day AggregatedTokenPnLTimeframe = "30d"
 )
 
+// Valid reports whether t is a supported timeframe.
+func (t AggregatedTokenPnLTimeframe) Valid() bool {
+	switch t {
+	case AggregatedTokenPnLTimeframe1Day, AggregatedTokenPnLTimeframe7Day, AggregatedTokenPnLTimeframe30Day:
+		return true
+	default:
+		return false
+	}
+}
+
 type AggregatedTokenPnLRequest struct {
-	Wallet       string
-	Chains       []chains.ChainType
-	Timeframe    *AggregatedTokenPnLTimeframe
-	CexTransfers *bool
+	Wallet       string                       `query:"-"`
+	Chains       []chains.ChainType           `query:"chains,comma"`
+	Timeframe    *AggregatedTokenPnLTimeframe `query:"timeframe"`
+	CexTransfers *bool                        `query:"cex_transfers"`
 }
 
 func (r *AggregatedTokenPnLRequest) GetQueryString() string {
-	values := url.Values{}
-
-	// Add chains if specified
-	if len(r.Chains) > 0 {
-		var chainStrs []string
-		for _, chain := range r.Chains {
-			chainStrs = append(chainStrs, string(chain))
-		}
-		values.Add("chains", strings.Join(chainStrs, ","))
-	}
-
-	// Add timeframe if specified
-	if r.Timeframe != nil {
-		values.Add("timeframe", string(*r.Timeframe))
-	}
+	return EncodeQuery(r).Encode()
+}
 
-	// Add CexTransfers if specified
-	if r.CexTransfers != nil {
-		values.Add("cex_transfers", strconv.FormatBool(*r.CexTransfers))
-	}
+// Validate checks that the wallet is set and the timeframe is supported.
+func (r *AggregatedTokenPnLRequest) Validate() error {
+	v := api.Validator{}
+	v.Required(r.Wallet, "Wallet")
+	v.Check(r.Timeframe == nil || r.Timeframe.Valid(), "Timeframe", "must be one of 1d, 7d, 30d")
 
-	return values.Encode()
+	return v.Err("AggregatedTokenPnLRequest")
 }
 
 type AggregatedTokenPnLResponse struct {
@@ -1,112 +1,60 @@
 package apiv1
 
 import (
-	"fmt"
-	"net/url"
-	"strconv"
-	"strings"
-
+	"github.com/sealtv/cielogo/api"
 	"github.com/sealtv/cielogo/api/chains"
 )
 
+// MaxFeedLimit is the maximum FeedRequest.Limit.
+const MaxFeedLimit = 100
+
 type FeedRequest struct {
 	// Filter the feed by a specific wallet address.
-	Wallet string
+	Wallet string `query:"wallet,omitempty"`
 	// Limit the number of transactions returned in the feed.
 	// The maximum limit is 100.
-	Limit *int
+	Limit *int `query:"limit"`
 	// Filter transactions by a specific List ID.
-	List *int
+	List *int `query:"list"`
 	// Filter transactions by specific blockchain chains (e.g. ethereum), comma-separated for multiple values (e.g, ethereum,polygon)
-	Chains []chains.ChainType
+	Chains []chains.ChainType `query:"chains,comma"`
 	// Filter transactions by types (e.g. swap, nft_trade), comma-separated for multiple values (e.g, swap,transfer,nft_trade)
-	TxTypes []TxType
+	TxTypes []TxType `query:"tx_types,comma"`
 	// Filter transactions by specific tokens, identified by either their address or symbol,
 	// comma-separated for multiple values (e.g, LINK,BITCOIN)
-	Tokens []string
+	Tokens []string `query:"tokens,comma"`
 	// Set a minimum USD value for transactions. Default - 0
-	MinUSD *float64
+	MinUSD *float64 `query:"minUSD"`
 	// Filter transactions by new trades.
-	NewTrades *bool
+	NewTrades *bool `query:"newTrades"`
 	// Set value from response 'paging.next_object_id' to get next page.
-	StartFrom *string
+	StartFrom *string `query:"startFrom,omitempty"`
 	// Filter transactions from a specific UNIX timestamp.
-	FromTimestamp *int64
+	FromTimestamp *int64 `query:"fromTimestamp"`
 	// Filter transactions to a specific UNIX timestamp.
-	ToTimestamp *int64
+	ToTimestamp *int64 `query:"toTimestamp"`
 	// Set a maximum USD value for transactions (upper bound filter).
-	MaxUSD *float64
+	MaxUSD *float64 `query:"maxUSD"`
 	// Include market cap data in the response.
 	// WARNING: Setting this to true DOUBLES the credit cost (10 credits instead of 5, or 6 instead of 3 when filtered by wallet).
-	IncludeMarketCap *bool
+	IncludeMarketCap *bool `query:"includeMarketCap"`
 }
 
 func (r *FeedRequest) GetQueryString() string {
-	values := url.Values{}
-
-	if r.Wallet != "" {
-		values.Add("wallet", r.Wallet)
-	}
-
-	if r.Limit != nil {
-		values.Add("limit", strconv.Itoa(*r.Limit))
-	}
-
-	if r.List != nil {
-		values.Add("list", strconv.Itoa(*r.List))
-	}
-
-	if len(r.Chains) > 0 {
-		chainStrs := make([]string, 0, len(r.Chains))
-		for _, chain := range r.Chains {
-			chainStrs = append(chainStrs, string(chain))
-		}
-
-		values.Add("chains", strings.Join(chainStrs, ","))
-	}
-
-	if len(r.TxTypes) > 0 {
-		txTypeStrs := make([]string, 0, len(r.TxTypes))
-		for _, txType := range r.TxTypes {
-			txTypeStrs = append(txTypeStrs, string(txType))
-		}
-
-		values.Add("tx_types", strings.Join(txTypeStrs, ","))
-	}
-
-	if len(r.Tokens) > 0 {
-		values.Add("tokens", strings.Join(r.Tokens, ","))
-	}
-
-	if r.MinUSD != nil {
-		values.Add("minUSD", fmt.Sprintf("%f", *r.MinUSD))
-	}
-
-	if r.NewTrades != nil {
-		values.Add("newTrades", strconv.FormatBool(*r.NewTrades))
-	}
-
-	if r.StartFrom != nil {
-		values.Add("startFrom", *r.StartFrom)
-	}
-
-	if r.FromTimestamp != nil {
-		values.Add("fromTimestamp", strconv.FormatInt(*r.FromTimestamp, 10))
-	}
-
-	if r.ToTimestamp != nil {
-		values.Add("toTimestamp", strconv.FormatInt(*r.ToTimestamp, 10))
-	}
-
-	if r.MaxUSD != nil {
-		values.Add("maxUSD", fmt.Sprintf("%f", *r.MaxUSD))
-	}
-
-	if r.IncludeMarketCap != nil {
-		values.Add("includeMarketCap", strconv.FormatBool(*r.IncludeMarketCap))
-	}
+	return EncodeQuery(r).Encode()
+}
 
-	return values.Encode()
+// Validate checks the request against the constraints of the feed endpoint.
+func (r *FeedRequest) Validate() error {
+	v := api.Validator{}
+	v.Check(r.Limit == nil || (*r.Limit >= 1 && *r.Limit <= MaxFeedLimit), "Limit", "must be between 1 and %d", MaxFeedLimit)
+	v.Check(r.MinUSD == nil || *r.MinUSD >= 0, "MinUSD", "must not be negative")
+	v.Check(r.MaxUSD == nil || *r.MaxUSD >= 0, "MaxUSD", "must not be negative")
+	v.Check(r.MinUSD == nil || r.MaxUSD == nil || *r.MinUSD <= *r.MaxUSD, "MaxUSD", "must not be less than MinUSD")
+	v.Check(r.FromTimestamp == nil || r.ToTimestamp == nil || *r.FromTimestamp <= *r.ToTimestamp,
+		"ToTimestamp", "must not be before FromTimestamp")
+
+	return v.Err("FeedRequest")
 }
 
 type FeedResponse struct {
@@ -1,5 +1,7 @@
 package apiv1
 
+import "github.com/sealtv/cielogo/api"
+
 type WalletsListOrdering string
 
 const (
@@ -7,10 +9,27 @@ const (
 	NewWalletsListOrdering     WalletsListOrdering = "new"
 )
 
+// Valid reports whether o is a supported ordering.
+func (o WalletsListOrdering) Valid() bool {
+	return o == PopularWalletsListOrdering || o == NewWalletsListOrdering
+}
+
 type GetAllWalletsListsRequest struct {
-	FollowOnly bool
-	Order      *WalletsListOrdering
-	NextObject *string
+	FollowOnly bool                 `query:"follow_only,omitempty"`
+	Order      *WalletsListOrdering `query:"order,omitempty"`
+	NextObject *string              `query:"next_object,omitempty"`
+}
+
+func (r *GetAllWalletsListsRequest) GetQueryString() string {
+	return EncodeQuery(r).Encode()
+}
+
+// Validate checks that the ordering is supported.
+func (r *GetAllWalletsListsRequest) Validate() error {
+	v := api.Validator{}
+	v.Check(r.Order == nil || *r.Order == "" || r.Order.Valid(), "Order", "must be one of popular, new")
+
+	return v.Err("GetAllWalletsListsRequest")
 }
 
 type GetAllWalletsListsResponse struct {
@@ -26,6 +45,14 @@ type AddWalletsListRequest struct {
 	Description    string   `json:"description,omitempty"`
 }
 
+// Validate checks that the name is set.
+func (r *AddWalletsListRequest) Validate() error {
+	v := api.Validator{}
+	v.Required(r.Name, "Name")
+
+	return v.Err("AddWalletsListRequest")
+}
+
 type UpdateWalletsListRequest struct {
 	ListID int64 `json:"-"`
 
@@ -36,6 +63,15 @@ type UpdateWalletsListRequest struct {
 	Description    string   `json:"description,omitempty"`
 }
 
+// Validate checks that the list ID and the name are set.
+func (r *UpdateWalletsListRequest) Validate() error {
+	v := api.Validator{}
+	v.Check(r.ListID > 0, "ListID", "is required")
+	v.Required(r.Name, "Name")
+
+	return v.Err("UpdateWalletsListRequest")
+}
+
 type ToggleFollowWalletsListResponce struct {
 	Followed bool `json:"followed"`
 }
@@ -0,0 +1,577 @@
+package apiv1
+
+import (
+	"strings"
+
+	"github.com/sealtv/cielogo/api/address"
+	"github.com/sealtv/cielogo/api/chains"
+)
+
+// MovementDirection tells whether an asset entered or left the wallet.
+type MovementDirection string
+
+const (
+	MovementIn  MovementDirection = "in"
+	MovementOut MovementDirection = "out"
+)
+
+// AssetKind tells whether a moved asset is a fungible token or an NFT.
+type AssetKind string
+
+const (
+	AssetFungible AssetKind = "fungible"
+	AssetNFT      AssetKind = "nft"
+)
+
+// AssetMovement is an asset that entered or left the wallet of a transaction.
+type AssetMovement struct {
+	// Direction is relative to the wallet of the transaction.
+	Direction MovementDirection
+	// Kind is the kind of asset.
+	Kind AssetKind
+	// Chain is the chain the asset moved on; for bridges it differs between the two legs.
+	Chain chains.ChainType
+	// TokenAddress is the token or NFT collection address, empty for native tokens.
+	TokenAddress string
+	// Symbol is the token or collection symbol.
+	Symbol string
+	// TokenID is the NFT token ID, empty for fungible tokens.
+	TokenID string
+	// Amount is the number of tokens or NFTs moved, zero when the event does not tell.
+	Amount float64
+	// UsdValue is the USD value of the movement, zero when the event does not tell. Every leg
+	// carries its own value, so both legs of an exchange, such as an NFT and its price, are
+	// valued; see NetUsdValue for the value a transaction added to or removed from the wallet.
+	UsdValue float64
+	// Counterparty is the address the asset came from or went to, when known.
+	Counterparty string
+}
+
+// NetUsdValue returns the USD value that entered the wallet minus the USD value that left it.
+// Legs exchanged for each other, such as a wrap or a flashloan and its repayment, cancel out.
+func NetUsdValue(movements []AssetMovement) float64 {
+	var net float64
+	for _, m := range movements {
+		if m.Direction == MovementIn {
+			net += m.UsdValue
+		} else {
+			net -= m.UsdValue
+		}
+	}
+
+	return net
+}
+
+// Movements returns the assets that entered or left the wallet in the transaction, see AssetMovements.
+func (t TxEvent) Movements() []AssetMovement {
+	if t.Data == nil {
+		return nil
+	}
+
+	return AssetMovements(t.Data)
+}
+
+// AssetMovements converts a transaction event into the assets that entered or left its wallet.
+// Legs whose direction cannot be told from the event are left out. Swap events only describe
+// the token bought or sold, so a swap yields a single leg and the asset paid or received for it
+// is missing. Perps, options, contract creations and interactions and unknown transaction types
+// move no assets the event describes and return nil.
+func AssetMovements(event TransactionEvent) []AssetMovement {
+	switch e := event.(type) {
+	case *SwapEvent:
+		return swapMovements(e)
+	case *TransferEvent:
+		return transferMovements(e)
+	case *LpEvent:
+		return lpMovements(e)
+	case *NftTradeEvent:
+		return nftTrade{
+			wallet: e.Wallet, chain: e.Chain, buyer: e.Buyer, seller: e.Seller, action: e.Action,
+			nftAddress: e.NftAddress, nftSymbol: e.NftSymbol, tokenID: e.NftTokenId,
+			currencyAddress: e.Token, currencySymbol: e.CurrencySymbol, price: e.Price, priceUsd: e.PriceUsd,
+		}.movements()
+	case *NftSweepEvent:
+		return nftTrade{
+			wallet: e.Wallet, chain: e.Chain, buyer: e.Buyer, seller: e.Seller, action: e.Action,
+			nftAddress: e.NftAddress, nftSymbol: e.NftSymbol, tokenID: e.NftTokenId,
+			currencyAddress: e.Token, currencySymbol: e.CurrencySymbol, price: e.Price, priceUsd: e.PriceUsd,
+		}.movements()
+	case *WrapEvent:
+		return wrapMovements(e)
+	case *RewardEvent:
+		return []AssetMovement{{
+			Direction:    MovementIn,
+			Kind:         AssetFungible,
+			Chain:        e.Chain,
+			TokenAddress: e.Address,
+			Symbol:       e.Symbol,
+			Amount:       e.Amount,
+			UsdValue:     e.AmountUsd,
+			Counterparty: e.From,
+		}}
+	case *StakingEvent:
+		return stakingMovements(e)
+	case *BridgeEvent:
+		return bridgeMovements(e)
+	case *LendingEvent:
+		return lendingMovements(e)
+	case *FlashloanEvent:
+		return flashloanMovements(e)
+	case *NftMintEvent:
+		return nftMintMovements(e)
+	case *NftTransferEvent:
+		return nftTransferMovements(e)
+	case *NftLendingEvent:
+		return nftLendingMovements(e)
+	case *NftLiquidationEvent:
+		return nftLiquidationMovements(e)
+	case *SudoPoolEvent:
+		return sudoPoolMovements(e)
+	default:
+		return nil
+	}
+}
+
+// flow returns the direction of an asset moving from one address to another, relative to the
+// wallet, and the address on the other side. It reports false if the wallet is on neither side.
+func flow(wallet, from, to string) (MovementDirection, string, bool) {
+	switch {
+	case wallet == "":
+		return "", "", false
+	case address.Equal(to, wallet) && !address.Equal(from, wallet):
+		return MovementIn, from, true
+	case address.Equal(from, wallet) && !address.Equal(to, wallet):
+		return MovementOut, to, true
+	default:
+		return "", "", false
+	}
+}
+
+// opposite returns the other direction.
+func opposite(d MovementDirection) MovementDirection {
+	if d == MovementIn {
+		return MovementOut
+	}
+
+	return MovementIn
+}
+
+// actionIs reports whether action is one of the values, ignoring case.
+func actionIs(action string, values ...string) bool {
+	for _, v := range values {
+		if strings.EqualFold(action, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// swapMovements returns the leg of the bought or sold token; the event does not describe the
+// other side of the swap.
+func swapMovements(s *SwapEvent) []AssetMovement {
+	direction, counterparty, ok := flow(s.Wallet, s.From, s.To)
+	switch {
+	case actionIs(s.Type, "buy"):
+		direction, ok = MovementIn, true
+	case actionIs(s.Type, "sell"):
+		direction, ok = MovementOut, true
+	}
+	if !ok {
+		return nil
+	}
+
+	return []AssetMovement{{
+		Direction:    direction,
+		Kind:         AssetFungible,
+		Chain:        s.Chain,
+		TokenAddress: s.TokenAddress,
+		Symbol:       s.TokenSymbol,
+		Amount:       s.Amount,
+		UsdValue:     s.AmountUsd,
+		Counterparty: counterparty,
+	}}
+}
+
+func transferMovements(t *TransferEvent) []AssetMovement {
+	direction, counterparty, ok := flow(t.Wallet, t.From, t.To)
+	if !ok {
+		return nil
+	}
+
+	var amount float64
+	if t.TokenPriceUsd > 0 {
+		amount = t.AmountUsd / t.TokenPriceUsd
+	}
+
+	return []AssetMovement{{
+		Direction:    direction,
+		Kind:         AssetFungible,
+		Chain:        t.Chain,
+		TokenAddress: t.ContractAddress,
+		Symbol:       t.Symbol,
+		Amount:       amount,
+		UsdValue:     t.AmountUsd,
+		Counterparty: counterparty,
+	}}
+}
+
+func lpMovements(l *LpEvent) []AssetMovement {
+	var direction MovementDirection
+	switch l.Type {
+	case LpTypeAddLpType:
+		direction = MovementOut
+	case LpTypeRemove:
+		direction = MovementIn
+	default:
+		return nil
+	}
+
+	legs := []AssetMovement{
+		{
+			Direction:    direction,
+			Kind:         AssetFungible,
+			Chain:        l.Chain,
+			TokenAddress: l.Token0Address,
+			Symbol:       l.Token0Symbol,
+			Amount:       l.Token0Amount,
+			UsdValue:     l.Token0AmountUSD,
+		},
+		{
+			Direction:    direction,
+			Kind:         AssetFungible,
+			Chain:        l.Chain,
+			TokenAddress: l.Token1Address,
+			Symbol:       l.Token1Symbol,
+			Amount:       l.Token1Amount,
+			UsdValue:     l.Token1AmountUSD,
+		},
+	}
+
+	return withoutEmptyLegs(legs)
+}
+
+// nftTrade holds the fields shared by NFT trades and sweeps.
+type nftTrade struct {
+	wallet, buyer, seller, action   string
+	chain                           chains.ChainType
+	nftAddress, nftSymbol, tokenID  string
+	currencyAddress, currencySymbol string
+	price, priceUsd                 float64
+}
+
+// movements returns the NFT and payment legs of the trade, both valued at the price.
+func (t nftTrade) movements() []AssetMovement {
+	var nftDirection MovementDirection
+	var counterparty string
+	switch {
+	case t.wallet != "" && address.Equal(t.buyer, t.wallet):
+		nftDirection, counterparty = MovementIn, t.seller
+	case t.wallet != "" && address.Equal(t.seller, t.wallet):
+		nftDirection, counterparty = MovementOut, t.buyer
+	case actionIs(t.action, "buy"):
+		nftDirection, counterparty = MovementIn, t.seller
+	case actionIs(t.action, "sell"):
+		nftDirection, counterparty = MovementOut, t.buyer
+	default:
+		return nil
+	}
+
+	return []AssetMovement{
+		{
+			Direction:    nftDirection,
+			Kind:         AssetNFT,
+			Chain:        t.chain,
+			TokenAddress: t.nftAddress,
+			Symbol:       t.nftSymbol,
+			TokenID:      t.tokenID,
+			Amount:       1,
+			UsdValue:     t.priceUsd,
+			Counterparty: counterparty,
+		},
+		{
+			Direction:    opposite(nftDirection),
+			Kind:         AssetFungible,
+			Chain:        t.chain,
+			TokenAddress: t.currencyAddress,
+			Symbol:       t.currencySymbol,
+			Amount:       t.price,
+			UsdValue:     t.priceUsd,
+			Counterparty: counterparty,
+		},
+	}
+}
+
+// wrapMovements returns the native and wrapped legs of a wrap or unwrap. The event describes
+// the wrapped token; the native token is taken from the chains registry.
+func wrapMovements(w *WrapEvent) []AssetMovement {
+	var wrapped MovementDirection
+	switch {
+	case actionIs(w.Action, "wrap"):
+		wrapped = MovementIn
+	case actionIs(w.Action, "unwrap"):
+		wrapped = MovementOut
+	default:
+		return nil
+	}
+
+	return []AssetMovement{
+		{
+			Direction:    opposite(wrapped),
+			Kind:         AssetFungible,
+			Chain:        w.Chain,
+			Symbol:       w.Chain.Info().NativeSymbol,
+			Amount:       w.Amount,
+			UsdValue:     w.AmountUsd,
+			Counterparty: w.ContractAddress,
+		},
+		{
+			Direction:    wrapped,
+			Kind:         AssetFungible,
+			Chain:        w.Chain,
+			TokenAddress: w.ContractAddress,
+			Symbol:       w.Symbol,
+			Amount:       w.Amount,
+			UsdValue:     w.AmountUsd,
+			Counterparty: w.ContractAddress,
+		},
+	}
+}
+
+func stakingMovements(s *StakingEvent) []AssetMovement {
+	direction, counterparty, ok := flow(s.Wallet, s.From, s.To)
+	switch {
+	case actionIs(s.Action, "stake", "deposit"):
+		direction, ok = MovementOut, true
+	case actionIs(s.Action, "unstake", "withdraw", "claim"):
+		direction, ok = MovementIn, true
+	}
+	if !ok {
+		return nil
+	}
+
+	return []AssetMovement{{
+		Direction:    direction,
+		Kind:         AssetFungible,
+		Chain:        s.Chain,
+		TokenAddress: s.ContractAddress,
+		Symbol:       s.Symbol,
+		Amount:       s.Amount,
+		UsdValue:     s.AmountUsd,
+		Counterparty: counterparty,
+	}}
+}
+
+// bridgeMovements returns the outgoing leg on the source chain when the wallet sent the assets
+// and the incoming leg on the destination chain when it received them, both when it bridged to itself.
+func bridgeMovements(b *BridgeEvent) []AssetMovement {
+	leg := AssetMovement{
+		Kind:         AssetFungible,
+		TokenAddress: b.TokenAddress,
+		Symbol:       b.TokenSymbol,
+		Amount:       b.Amoun,
+		UsdValue:     b.AmountUSD,
+	}
+
+	var legs []AssetMovement
+	if b.Wallet != "" && address.Equal(b.From, b.Wallet) {
+		out := leg
+		out.Direction, out.Chain, out.Counterparty = MovementOut, chainOr(b.FromChain, b.Chain), b.To
+		legs = append(legs, out)
+	}
+	if b.Wallet != "" && address.Equal(b.To, b.Wallet) {
+		in := leg
+		in.Direction, in.Chain, in.Counterparty = MovementIn, chainOr(b.ToChain, b.Chain), b.From
+		legs = append(legs, in)
+	}
+
+	return legs
+}
+
+func chainOr(chain, fallback chains.ChainType) chains.ChainType {
+	if chain == "" {
+		return fallback
+	}
+
+	return chain
+}
+
+func lendingMovements(l *LendingEvent) []AssetMovement {
+	var direction MovementDirection
+	switch {
+	case actionIs(l.Action, "deposit", "supply", "repay"):
+		direction = MovementOut
+	case actionIs(l.Action, "borrow", "withdraw"):
+		direction = MovementIn
+	default:
+		return nil
+	}
+
+	return []AssetMovement{{
+		Direction:    direction,
+		Kind:         AssetFungible,
+		Chain:        l.Chain,
+		TokenAddress: l.Address,
+		Symbol:       l.Symbol,
+		Amount:       l.Amount,
+		UsdValue:     l.AmountUSD,
+	}}
+}
+
+// flashloanMovements returns the borrowed and the repaid legs, which cancel each other out.
+func flashloanMovements(f *FlashloanEvent) []AssetMovement {
+	leg := AssetMovement{
+		Kind:         AssetFungible,
+		Chain:        f.Chain,
+		TokenAddress: f.Address,
+		Symbol:       f.Symbol,
+		Amount:       f.Amount,
+		UsdValue:     f.AmountUsd,
+		Counterparty: f.To,
+	}
+	borrowed, repaid := leg, leg
+	borrowed.Direction, repaid.Direction = MovementIn, MovementOut
+
+	return []AssetMovement{borrowed, repaid}
+}
+
+// nftMintMovements returns the minted NFTs, valued at the mint price, and the price paid, if any.
+func nftMintMovements(n *NftMintEvent) []AssetMovement {
+	amount := n.Amount
+	if amount == 0 {
+		amount = 1
+	}
+
+	legs := []AssetMovement{{
+		Direction:    MovementIn,
+		Kind:         AssetNFT,
+		Chain:        n.Chain,
+		TokenAddress: n.ContractAddress,
+		Symbol:       n.NftSymbol,
+		TokenID:      n.NftToekenId,
+		Amount:       amount,
+		UsdValue:     n.ValueUsd,
+		Counterparty: n.From,
+	}}
+
+	if n.Value > 0 {
+		legs = append(legs, AssetMovement{
+			Direction:    MovementOut,
+			Kind:         AssetFungible,
+			Chain:        n.Chain,
+			Symbol:       n.CurrencySymbol,
+			Amount:       n.Value,
+			UsdValue:     n.ValueUsd,
+			Counterparty: n.ContractAddress,
+		})
+	}
+
+	return legs
+}
+
+func nftTransferMovements(n *NftTransferEvent) []AssetMovement {
+	direction, counterparty, ok := flow(n.Wallet, n.From, n.To)
+	if !ok {
+		return nil
+	}
+
+	return []AssetMovement{{
+		Direction:    direction,
+		Kind:         AssetNFT,
+		Chain:        n.Chain,
+		TokenAddress: n.ConstractAddress,
+		Symbol:       n.NftSymbol,
+		TokenID:      n.NftTokenId,
+		Amount:       1,
+		Counterparty: counterparty,
+	}}
+}
+
+// nftLendingMovements returns the loan currency leg of an NFT-backed loan.
+func nftLendingMovements(n *NftLendingEvent) []AssetMovement {
+	var direction MovementDirection
+	switch {
+	case actionIs(n.Action, "borrow"):
+		direction = MovementIn
+	case actionIs(n.Action, "repay", "lend"):
+		direction = MovementOut
+	default:
+		return nil
+	}
+
+	_, counterparty, _ := flow(n.Wallet, n.From, n.To)
+
+	return []AssetMovement{{
+		Direction:    direction,
+		Kind:         AssetFungible,
+		Chain:        n.Chain,
+		TokenAddress: n.CurrencyAddress,
+		Symbol:       n.CurrenctSymbol,
+		Amount:       n.Price,
+		UsdValue:     n.PriceUSD,
+		Counterparty: counterparty,
+	}}
+}
+
+func nftLiquidationMovements(n *NftLiquidationEvent) []AssetMovement {
+	direction, counterparty, ok := flow(n.Wallet, n.From, n.To)
+	if !ok {
+		return nil
+	}
+
+	return []AssetMovement{{
+		Direction:    direction,
+		Kind:         AssetNFT,
+		Chain:        n.Chain,
+		TokenAddress: n.NftAddress,
+		Symbol:       n.NftSymbol,
+		TokenID:      n.TokenId,
+		Amount:       1,
+		UsdValue:     n.PriceUsd,
+		Counterparty: counterparty,
+	}}
+}
+
+// sudoPoolMovements returns the NFT and token legs of a sudoswap pool deposit or withdrawal.
+func sudoPoolMovements(s *SudoPoolEvent) []AssetMovement {
+	direction, counterparty, ok := flow(s.Wallet, s.From, s.To)
+	if !ok {
+		return nil
+	}
+
+	legs := []AssetMovement{
+		{
+			Direction:    direction,
+			Kind:         AssetNFT,
+			Chain:        s.Chain,
+			TokenAddress: s.NftAddress,
+			Symbol:       s.NftSymbol,
+			Amount:       float64(s.NftAmount),
+			Counterparty: counterparty,
+		},
+		{
+			Direction:    direction,
+			Kind:         AssetFungible,
+			Chain:        s.Chain,
+			TokenAddress: s.Token0Address,
+			Symbol:       s.Token0Symbol,
+			Amount:       s.Token0Amount,
+			UsdValue:     s.Token0AmountUsd,
+			Counterparty: counterparty,
+		},
+	}
+
+	return withoutEmptyLegs(legs)
+}
+
+// withoutEmptyLegs drops the legs that moved nothing.
+func withoutEmptyLegs(legs []AssetMovement) []AssetMovement {
+	var out []AssetMovement
+	for _, leg := range legs {
+		if leg.Amount != 0 || leg.UsdValue != 0 {
+			out = append(out, leg)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,168 @@
+package apiv1_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/api/chains"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetMovements(t *testing.T) {
+	const wallet = "0xw"
+
+	tests := []struct {
+		name  string
+		event apiv1.TransactionEvent
+		want  []apiv1.AssetMovement
+	}{
+		{
+			"swap buy",
+			&apiv1.SwapEvent{Wallet: wallet, Chain: chains.Base, From: "0xpool", To: wallet, TokenAddress: "0xa", TokenSymbol: "PEPE", Amount: 100, AmountUsd: 30, Type: "buy"},
+			[]apiv1.AssetMovement{{Direction: apiv1.MovementIn, Kind: apiv1.AssetFungible, Chain: chains.Base, TokenAddress: "0xa", Symbol: "PEPE", Amount: 100, UsdValue: 30, Counterparty: "0xpool"}},
+		},
+		{
+			"swap sell without addresses",
+			&apiv1.SwapEvent{Wallet: wallet, TokenSymbol: "PEPE", Amount: 5, Type: "sell"},
+			[]apiv1.AssetMovement{{Direction: apiv1.MovementOut, Kind: apiv1.AssetFungible, Symbol: "PEPE", Amount: 5}},
+		},
+		{
+			"transfer out",
+			&apiv1.TransferEvent{Wallet: wallet, From: wallet, To: "0xr", ContractAddress: "0xusdc", Symbol: "USDC", AmountUsd: 50, TokenPriceUsd: 1},
+			[]apiv1.AssetMovement{{Direction: apiv1.MovementOut, Kind: apiv1.AssetFungible, TokenAddress: "0xusdc", Symbol: "USDC", Amount: 50, UsdValue: 50, Counterparty: "0xr"}},
+		},
+		{"transfer unrelated", &apiv1.TransferEvent{Wallet: wallet, From: "0x1", To: "0x2"}, nil},
+		{
+			"lp add",
+			&apiv1.LpEvent{Wallet: wallet, Type: apiv1.LpTypeAddLpType, Token0Address: "0xa", Token0Symbol: "A", Token0Amount: 1, Token0AmountUSD: 2, Token1Address: "0xb", Token1Symbol: "B", Token1Amount: 3, Token1AmountUSD: 4},
+			[]apiv1.AssetMovement{
+				{Direction: apiv1.MovementOut, Kind: apiv1.AssetFungible, TokenAddress: "0xa", Symbol: "A", Amount: 1, UsdValue: 2},
+				{Direction: apiv1.MovementOut, Kind: apiv1.AssetFungible, TokenAddress: "0xb", Symbol: "B", Amount: 3, UsdValue: 4},
+			},
+		},
+		{
+			"lp remove single sided",
+			&apiv1.LpEvent{Wallet: wallet, Type: apiv1.LpTypeRemove, Token0Address: "0xa", Token0Amount: 1},
+			[]apiv1.AssetMovement{{Direction: apiv1.MovementIn, Kind: apiv1.AssetFungible, TokenAddress: "0xa", Amount: 1}},
+		},
+		{
+			"nft trade buy",
+			&apiv1.NftTradeEvent{Wallet: wallet, Buyer: wallet, Seller: "0xs", NftAddress: "0xnft", NftSymbol: "PUNK", NftTokenId: "7", Token: "0xweth", CurrencySymbol: "WETH", Price: 2, PriceUsd: 6000},
+			[]apiv1.AssetMovement{
+				{Direction: apiv1.MovementIn, Kind: apiv1.AssetNFT, TokenAddress: "0xnft", Symbol: "PUNK", TokenID: "7", Amount: 1, UsdValue: 6000, Counterparty: "0xs"},
+				{Direction: apiv1.MovementOut, Kind: apiv1.AssetFungible, TokenAddress: "0xweth", Symbol: "WETH", Amount: 2, UsdValue: 6000, Counterparty: "0xs"},
+			},
+		},
+		{
+			"nft sweep sell",
+			&apiv1.NftSweepEvent{Wallet: wallet, Action: "sell", Buyer: "0xb", NftAddress: "0xnft", CurrencySymbol: "ETH", Price: 1, PriceUsd: 3000},
+			[]apiv1.AssetMovement{
+				{Direction: apiv1.MovementOut, Kind: apiv1.AssetNFT, TokenAddress: "0xnft", Amount: 1, UsdValue: 3000, Counterparty: "0xb"},
+				{Direction: apiv1.MovementIn, Kind: apiv1.AssetFungible, Symbol: "ETH", Amount: 1, UsdValue: 3000, Counterparty: "0xb"},
+			},
+		},
+		{
+			"wrap",
+			&apiv1.WrapEvent{Wallet: wallet, Chain: chains.Ethereum, Action: "wrap", ContractAddress: "0xweth", Symbol: "WETH", Amount: 1, AmountUsd: 3000},
+			[]apiv1.AssetMovement{
+				{Direction: apiv1.MovementOut, Kind: apiv1.AssetFungible, Chain: chains.Ethereum, Symbol: "ETH", Amount: 1, UsdValue: 3000, Counterparty: "0xweth"},
+				{Direction: apiv1.MovementIn, Kind: apiv1.AssetFungible, Chain: chains.Ethereum, TokenAddress: "0xweth", Symbol: "WETH", Amount: 1, UsdValue: 3000, Counterparty: "0xweth"},
+			},
+		},
+		{
+			"reward",
+			&apiv1.RewardEvent{Wallet: wallet, From: "0xd", Address: "0xr", Symbol: "RWD", Amount: 4, AmountUsd: 8},
+			[]apiv1.AssetMovement{{Direction: apiv1.MovementIn, Kind: apiv1.AssetFungible, TokenAddress: "0xr", Symbol: "RWD", Amount: 4, UsdValue: 8, Counterparty: "0xd"}},
+		},
+		{
+			"stake",
+			&apiv1.StakingEvent{Wallet: wallet, Action: "stake", From: wallet, To: "0xv", ContractAddress: "0xs", Symbol: "S", Amount: 1},
+			[]apiv1.AssetMovement{{Direction: apiv1.MovementOut, Kind: apiv1.AssetFungible, TokenAddress: "0xs", Symbol: "S", Amount: 1, Counterparty: "0xv"}},
+		},
+		{
+			"bridge to self",
+			&apiv1.BridgeEvent{Wallet: wallet, Chain: chains.Ethereum, From: wallet, To: wallet, FromChain: chains.Ethereum, ToChain: chains.Base, TokenSymbol: "ETH", Amoun: 1, AmountUSD: 3000},
+			[]apiv1.AssetMovement{
+				{Direction: apiv1.MovementOut, Kind: apiv1.AssetFungible, Chain: chains.Ethereum, Symbol: "ETH", Amount: 1, UsdValue: 3000, Counterparty: wallet},
+				{Direction: apiv1.MovementIn, Kind: apiv1.AssetFungible, Chain: chains.Base, Symbol: "ETH", Amount: 1, UsdValue: 3000, Counterparty: wallet},
+			},
+		},
+		{
+			"lending borrow",
+			&apiv1.LendingEvent{Wallet: wallet, Action: "borrow", Address: "0xdai", Symbol: "DAI", Amount: 10, AmountUSD: 10},
+			[]apiv1.AssetMovement{{Direction: apiv1.MovementIn, Kind: apiv1.AssetFungible, TokenAddress: "0xdai", Symbol: "DAI", Amount: 10, UsdValue: 10}},
+		},
+		{
+			"flashloan",
+			&apiv1.FlashloanEvent{Wallet: wallet, Address: "0xa", Symbol: "A", Amount: 1, AmountUsd: 2, To: "0xp"},
+			[]apiv1.AssetMovement{
+				{Direction: apiv1.MovementIn, Kind: apiv1.AssetFungible, TokenAddress: "0xa", Symbol: "A", Amount: 1, UsdValue: 2, Counterparty: "0xp"},
+				{Direction: apiv1.MovementOut, Kind: apiv1.AssetFungible, TokenAddress: "0xa", Symbol: "A", Amount: 1, UsdValue: 2, Counterparty: "0xp"},
+			},
+		},
+		{
+			"nft mint",
+			&apiv1.NftMintEvent{Wallet: wallet, ContractAddress: "0xnft", NftSymbol: "N", NftToekenId: "1", CurrencySymbol: "ETH", Value: 0.1, ValueUsd: 300},
+			[]apiv1.AssetMovement{
+				{Direction: apiv1.MovementIn, Kind: apiv1.AssetNFT, TokenAddress: "0xnft", Symbol: "N", TokenID: "1", Amount: 1, UsdValue: 300},
+				{Direction: apiv1.MovementOut, Kind: apiv1.AssetFungible, Symbol: "ETH", Amount: 0.1, UsdValue: 300, Counterparty: "0xnft"},
+			},
+		},
+		{
+			"nft transfer in",
+			&apiv1.NftTransferEvent{Wallet: wallet, From: "0xf", To: wallet, ConstractAddress: "0xnft", NftTokenId: "3"},
+			[]apiv1.AssetMovement{{Direction: apiv1.MovementIn, Kind: apiv1.AssetNFT, TokenAddress: "0xnft", TokenID: "3", Amount: 1, Counterparty: "0xf"}},
+		},
+		{
+			"free nft mint",
+			&apiv1.NftMintEvent{Wallet: wallet, ContractAddress: "0xnft", NftToekenId: "2", ValueUsd: 5},
+			[]apiv1.AssetMovement{{Direction: apiv1.MovementIn, Kind: apiv1.AssetNFT, TokenAddress: "0xnft", TokenID: "2", Amount: 1, UsdValue: 5}},
+		},
+		{
+			"solana transfer is case-sensitive",
+			&apiv1.TransferEvent{Wallet: solanaWallet, From: "0x1", To: strings.ToLower(solanaWallet)},
+			nil,
+		},
+		{"perp", &apiv1.PerpEvent{Wallet: wallet, AmountUsd: 100}, nil},
+		{"option", &apiv1.OptionEvent{Wallet: wallet}, nil},
+		{"contract interaction", &apiv1.ContractInteractionEvent{Wallet: wallet}, nil},
+		{"unknown", &apiv1.UnknownEvent{Wallet: wallet, TxType: "restake"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, apiv1.AssetMovements(tt.event))
+		})
+	}
+}
+
+func TestNetUsdValue(t *testing.T) {
+	const wallet = "0xw"
+
+	wrap := apiv1.AssetMovements(&apiv1.WrapEvent{Wallet: wallet, Chain: chains.Ethereum, Action: "wrap", ContractAddress: "0xweth", Symbol: "WETH", Amount: 1, AmountUsd: 3000})
+	assert.InDelta(t, 0, apiv1.NetUsdValue(wrap), 1e-9, "a wrap is value-neutral")
+
+	flashloan := apiv1.AssetMovements(&apiv1.FlashloanEvent{Wallet: wallet, Address: "0xa", Amount: 1, AmountUsd: 2})
+	assert.InDelta(t, 0, apiv1.NetUsdValue(flashloan), 1e-9, "a flashloan is repaid")
+
+	transfer := apiv1.AssetMovements(&apiv1.TransferEvent{Wallet: wallet, From: wallet, To: "0xr", AmountUsd: 50, TokenPriceUsd: 1})
+	assert.InDelta(t, -50, apiv1.NetUsdValue(transfer), 1e-9)
+
+	assert.Zero(t, apiv1.NetUsdValue(nil))
+}
+
+func TestTxEvent_Movements(t *testing.T) {
+	var event apiv1.TxEvent
+	require.NoError(t, json.Unmarshal([]byte(swapTxEventJSON), &event))
+
+	movements := event.Movements()
+	require.Len(t, movements, 1)
+	assert.Equal(t, apiv1.MovementIn, movements[0].Direction)
+	assert.Equal(t, "PEPE", movements[0].Symbol)
+	assert.Equal(t, "0x2", movements[0].Counterparty)
+
+	assert.Nil(t, apiv1.TxEvent{}.Movements())
+}
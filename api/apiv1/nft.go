@@ -1,31 +1,26 @@
 package apiv1
 
 import (
-	"net/url"
-
+	"github.com/sealtv/cielogo/api"
 	"github.com/sealtv/cielogo/api/chains"
 )
 
 type NftsPnLRequest struct {
-	Wallet     string
-	Timeframe  *string
-	NextObject *string
+	Wallet     string  `query:"-"`
+	Timeframe  *string `query:"timeframe"`
+	NextObject *string `query:"next_object,omitempty"`
 }
 
 func (r *NftsPnLRequest) GetQueryString() string {
-	values := url.Values{}
-
-	// Add timeframe if specified
-	if r.Timeframe != nil {
-		values.Add("timeframe", *r.Timeframe)
-	}
+	return EncodeQuery(r).Encode()
+}
 
-	// Add NextObject if specified
-	if r.NextObject != nil {
-		values.Add("next_object", *r.NextObject)
-	}
+// Validate checks that the wallet is set.
+func (r *NftsPnLRequest) Validate() error {
+	v := api.Validator{}
+	v.Required(r.Wallet, "Wallet")
 
-	return values.Encode()
+	return v.Err("NftsPnLRequest")
 }
 
 // NftsPnLResponse
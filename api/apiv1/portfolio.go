@@ -1,8 +1,11 @@
 package apiv1
 
 import (
-	"net/url"
-	"strings"
+	"fmt"
+
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/address"
+	"github.com/sealtv/cielogo/api/chains"
 )
 
 // PortfolioAsset represents a single token asset in a wallet portfolio.
@@ -63,26 +66,31 @@ type WalletPortfolioV2Response struct {
 type WalletPortfolioV2Request struct {
 	// Wallets is a list of wallet addresses to retrieve portfolios for.
 	// Multiple wallets will be aggregated in the response.
-	Wallets []string
+	Wallets []string `query:"wallet,comma"`
 
 	// Token is an optional token mint address to filter for a specific token.
 	// Only supported for single Solana wallet queries.
 	// Returns 400 error if used with multiple wallets or non-Solana wallets.
-	Token *string
+	Token *string `query:"token,omitempty"`
 }
 
 // GetQueryString builds the query string for V2 portfolio requests.
 // Wallets are joined with commas, and token filter is added if present.
 func (r *WalletPortfolioV2Request) GetQueryString() string {
-	values := url.Values{}
+	return EncodeQuery(r).Encode()
+}
 
-	if len(r.Wallets) > 0 {
-		values.Add("wallet", strings.Join(r.Wallets, ","))
+// Validate checks that wallets are set and that Token is only used with a single Solana wallet.
+func (r *WalletPortfolioV2Request) Validate() error {
+	v := api.Validator{}
+	v.Check(len(r.Wallets) > 0, "Wallets", "is required")
+	for i, wallet := range r.Wallets {
+		v.Required(wallet, fmt.Sprintf("Wallets[%d]", i))
 	}
 
 	if r.Token != nil && *r.Token != "" {
-		values.Add("token", *r.Token)
+		v.Check(len(r.Wallets) == 1 && address.Detect(r.Wallets[0]) == chains.SolanaWalletType, "Token", "is only supported for a single Solana wallet")
 	}
 
-	return values.Encode()
+	return v.Err("WalletPortfolioV2Request")
 }
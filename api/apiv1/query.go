@@ -0,0 +1,126 @@
+package apiv1
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EncodeQuery encodes the fields of a request struct tagged with `query:"name[,options]"`
+// into query parameters. Untagged fields and fields tagged "-" (e.g. path parameters) are skipped.
+//
+// Nil pointers are omitted; non-nil pointers are encoded even if they point to a zero value,
+// unless the field has the omitempty option. Options:
+//
+//   - omitempty: omit zero values, including pointers to zero values
+//   - comma: join slice elements with commas instead of repeating the parameter
+//
+// Strings and string enums are encoded as is, booleans as true/false, floats in the shortest
+// decimal form without exponent, time.Time as a UNIX timestamp in seconds, and types implementing
+// encoding.TextMarshaler with MarshalText. Values are escaped by url.Values.Encode.
+// EncodeQuery panics on a field type it cannot encode, which is a programming error in the request type.
+func EncodeQuery(req any) url.Values {
+	values := url.Values{}
+
+	v := reflect.ValueOf(req)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return values
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("apiv1: cannot encode %s as query", v.Type()))
+	}
+
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag, ok := field.Tag.Lookup("query")
+		if !ok || tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		omitEmpty := strings.Contains(opts, "omitempty")
+		comma := strings.Contains(opts, "comma")
+
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+
+		if omitEmpty && fv.IsZero() {
+			continue
+		}
+
+		if fv.Kind() != reflect.Slice || fv.Type() == byteSliceType {
+			values.Add(name, formatQueryValue(fv))
+			continue
+		}
+
+		if fv.Len() == 0 {
+			continue
+		}
+
+		elems := make([]string, 0, fv.Len())
+		for j := range fv.Len() {
+			elems = append(elems, formatQueryValue(fv.Index(j)))
+		}
+
+		if comma {
+			values.Add(name, strings.Join(elems, ","))
+		} else {
+			values[name] = append(values[name], elems...)
+		}
+	}
+
+	return values
+}
+
+var (
+	byteSliceType     = reflect.TypeFor[[]byte]()
+	timeType          = reflect.TypeFor[time.Time]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// formatQueryValue formats a single query parameter value.
+func formatQueryValue(v reflect.Value) string {
+	if v.Type() == timeType {
+		return strconv.FormatInt(v.Interface().(time.Time).Unix(), 10) //nolint:errcheck // the type is checked above
+	}
+
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText() //nolint:errcheck // the type is checked above
+		if err != nil {
+			panic(fmt.Sprintf("apiv1: cannot encode %s as query: %v", v.Type(), err))
+		}
+		return string(text)
+	}
+
+	//nolint:exhaustive // the remaining kinds cannot be encoded
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())
+	case reflect.Slice:
+		return string(v.Bytes())
+	default:
+		panic(fmt.Sprintf("apiv1: cannot encode %s as query", v.Type()))
+	}
+}
@@ -0,0 +1,215 @@
+package apiv1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/api/chains"
+	"github.com/stretchr/testify/assert"
+)
+
+type upperText string
+
+func (u upperText) MarshalText() ([]byte, error) {
+	return []byte("UP-" + string(u)), nil
+}
+
+func TestEncodeQuery(t *testing.T) {
+	type request struct {
+		Path     string `query:"-"`
+		Untagged string
+		Name     string             `query:"name"`
+		Empty    string             `query:"empty,omitempty"`
+		Count    *int               `query:"count"`
+		Zero     *int               `query:"zero"`
+		ZeroOmit *int               `query:"zero_omit,omitempty"`
+		Missing  *bool              `query:"missing"`
+		Ratio    float64            `query:"ratio"`
+		Big      *float64           `query:"big"`
+		Enabled  bool               `query:"enabled,omitempty"`
+		Chains   []chains.ChainType `query:"chains,comma"`
+		Tags     []apiv1.TagType    `query:"tag"`
+		NoTags   []string           `query:"none"`
+		Since    time.Time          `query:"since,omitempty"`
+		Text     upperText          `query:"text"`
+		Unsigned uint               `query:"unsigned"`
+	}
+
+	req := &request{
+		Path:     "ignored",
+		Untagged: "ignored",
+		Name:     "a b&c=d/é",
+		Count:    apiv1.ToRef(3),
+		Zero:     apiv1.ToRef(0),
+		ZeroOmit: apiv1.ToRef(0),
+		Ratio:    0.1,
+		Big:      apiv1.ToRef(12345678901.5),
+		Enabled:  true,
+		Chains:   []chains.ChainType{chains.Ethereum, chains.Solana},
+		Tags:     []apiv1.TagType{apiv1.TagTypeMev, apiv1.TagTypeFlipper},
+		Since:    time.Unix(1700000000, 0),
+		Text:     "x",
+		Unsigned: 7,
+	}
+
+	values := apiv1.EncodeQuery(req)
+	assert.Equal(t, "a b&c=d/é", values.Get("name"))
+	assert.Equal(t, []string{"mev", "flipper"}, values["tag"])
+	assert.Equal(t,
+		"big=12345678901.5&chains=ethereum%2Csolana&count=3&enabled=true&name=a+b%26c%3Dd%2F%C3%A9&ratio=0.1"+
+			"&since=1700000000&tag=mev&tag=flipper&text=UP-x&unsigned=7&zero=0",
+		values.Encode())
+
+	assert.Empty(t, apiv1.EncodeQuery((*request)(nil)))
+	assert.Equal(t, "name=&ratio=0&text=UP-&unsigned=0", apiv1.EncodeQuery(request{}).Encode())
+}
+
+func TestEncodeQuery_UnsupportedType(t *testing.T) {
+	type request struct {
+		Nested struct{ A int } `query:"nested"`
+	}
+
+	assert.Panics(t, func() { apiv1.EncodeQuery(&request{}) })
+	assert.Panics(t, func() { apiv1.EncodeQuery("not a struct") })
+}
+
+// TestGetQueryString_AllRequests checks the exact query string of every request type.
+func TestGetQueryString_AllRequests(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      interface{ GetQueryString() string }
+		expected string
+	}{
+		{
+			name: "feed",
+			req: &apiv1.FeedRequest{
+				Wallet:           "0xabc",
+				Limit:            apiv1.ToRef(50),
+				List:             apiv1.ToRef(7),
+				Chains:           []chains.ChainType{chains.Ethereum, chains.Base},
+				TxTypes:          []apiv1.TxType{apiv1.TxTypeSwap, apiv1.TxTypeTransfer},
+				Tokens:           []string{"LINK", "BTC"},
+				MinUSD:           apiv1.ToRef(100.25),
+				NewTrades:        apiv1.ToRef(true),
+				StartFrom:        apiv1.ToRef("cursor/1"),
+				FromTimestamp:    apiv1.ToRef(int64(1700000000)),
+				ToTimestamp:      apiv1.ToRef(int64(1700003600)),
+				MaxUSD:           apiv1.ToRef(1000.5),
+				IncludeMarketCap: apiv1.ToRef(false),
+			},
+			expected: "chains=ethereum%2Cbase&fromTimestamp=1700000000&includeMarketCap=false&limit=50&list=7" +
+				"&maxUSD=1000.5&minUSD=100.25&newTrades=true&startFrom=cursor%2F1&toTimestamp=1700003600" +
+				"&tokens=LINK%2CBTC&tx_types=swap%2Ctransfer&wallet=0xabc",
+		},
+		{
+			name:     "feed empty",
+			req:      &apiv1.FeedRequest{StartFrom: apiv1.ToRef("")},
+			expected: "",
+		},
+		{
+			name:     "nfts pnl",
+			req:      &apiv1.NftsPnLRequest{Wallet: "0xabc", Timeframe: apiv1.ToRef("7d"), NextObject: apiv1.ToRef("n1")},
+			expected: "next_object=n1&timeframe=7d",
+		},
+		{
+			name: "tokens pnl",
+			req: &apiv1.TokensPnLRequest{
+				Wallet:              "0xabc",
+				Chains:              []chains.ChainType{chains.Solana},
+				Timeframe:           apiv1.ToRef("30d"),
+				NextObject:          apiv1.ToRef("n2"),
+				CexTransfers:        apiv1.ToRef(true),
+				Tokens:              []string{"SOL", "USDC"},
+				ActivePositionsOnly: apiv1.ToRef(false),
+			},
+			expected: "active_positions_only=false&cex_transfers=true&chains=solana&next_object=n2&timeframe=30d&token=SOL%2CUSDC",
+		},
+		{
+			name: "aggregated token pnl",
+			req: &apiv1.AggregatedTokenPnLRequest{
+				Wallet:       "0xabc",
+				Chains:       []chains.ChainType{chains.Ethereum, chains.Arbitrum},
+				Timeframe:    apiv1.ToRef(apiv1.AggregatedTokenPnLTimeframe7Day),
+				CexTransfers: apiv1.ToRef(false),
+			},
+			expected: "cex_transfers=false&chains=ethereum%2Carbitrum&timeframe=7d",
+		},
+		{
+			name:     "related wallets",
+			req:      &apiv1.RelatedWalletsRequest{Wallet: "0xabc", SortCriteria: apiv1.ToRef(apiv1.RelatedWalletsSorting("in flow&x"))},
+			expected: "sort_criteria=in+flow%26x",
+		},
+		{
+			name:     "wallets tags",
+			req:      &apiv1.GetWalletsTagsRequest{Wallets: []string{"0x1", "0x2"}},
+			expected: "wallet=0x1&wallet=0x2",
+		},
+		{
+			name: "wallets by tag",
+			req: &apiv1.GetWalletsByTagRequest{
+				Tags:       []apiv1.TagType{apiv1.TagTypeMev, apiv1.TagTypeGemFinder},
+				WalletType: apiv1.ToRef(apiv1.EvmWalletType),
+				Limit:      apiv1.ToRef(10),
+				NextObject: apiv1.ToRef("20"),
+			},
+			expected: "limit=10&next_object=20&tags=mev&tags=gem_finder&wallet_type=evm",
+		},
+		{
+			name:     "all wallets lists",
+			req:      &apiv1.GetAllWalletsListsRequest{FollowOnly: true, Order: apiv1.ToRef(apiv1.PopularWalletsListOrdering), NextObject: apiv1.ToRef("5")},
+			expected: "follow_only=true&next_object=5&order=popular",
+		},
+		{
+			name:     "all wallets lists defaults",
+			req:      &apiv1.GetAllWalletsListsRequest{Order: apiv1.ToRef(apiv1.WalletsListOrdering(""))},
+			expected: "",
+		},
+		{
+			name:     "tracked wallets",
+			req:      &apiv1.GetTrackedWalletsRequest{ListID: apiv1.ToRef(int64(42)), NextObject: apiv1.ToRef("20")},
+			expected: "list_id=42&next_object=20",
+		},
+		{
+			name:     "tracked wallets zero list",
+			req:      &apiv1.GetTrackedWalletsRequest{ListID: apiv1.ToRef(int64(0))},
+			expected: "",
+		},
+		{
+			name:     "portfolio v2",
+			req:      &apiv1.WalletPortfolioV2Request{Wallets: []string{"0x1", "Sol1"}, Token: apiv1.ToRef("mint")},
+			expected: "token=mint&wallet=0x1%2CSol1",
+		},
+		{
+			name:     "token metadata",
+			req:      &apiv1.TokenMetadataRequest{Chain: apiv1.TokenChainSolana, TokenAddress: "mint"},
+			expected: "chain=solana&token_address=mint",
+		},
+		{
+			name:     "token price",
+			req:      &apiv1.TokenPriceRequest{Chain: apiv1.TokenChainEthereum, TokenAddress: "0xtoken"},
+			expected: "chain=ethereum&token_address=0xtoken",
+		},
+		{
+			name:     "token stats",
+			req:      &apiv1.TokenStatsRequest{Chain: apiv1.TokenChainBase, TokenAddress: "0xtoken"},
+			expected: "chain=base&token_address=0xtoken",
+		},
+		{
+			name:     "token balance",
+			req:      &apiv1.TokenBalanceRequest{Wallet: "0xabc", Chain: apiv1.TokenChainHyperevm, TokenAddress: "0xtoken"},
+			expected: "chain=hyperevm&token_address=0xtoken",
+		},
+		{
+			name:     "trading stats",
+			req:      &apiv1.TradingStatsRequest{Wallet: "0xabc", Days: apiv1.ToRef(apiv1.Timeframe30Days)},
+			expected: "days=30d",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.req.GetQueryString())
+		})
+	}
+}
@@ -1,6 +1,6 @@
 package apiv1
 
-import "fmt"
+import "github.com/sealtv/cielogo/api"
 
 // RelatedWalletsSorting defines the sorting criteria for related wallets.
 // Use these constants to sort wallets by inflow, outflow, or transaction count.
@@ -21,20 +21,37 @@ const (
 	RelatedWalletsSortingTransactionsDesc RelatedWalletsSorting = "transactions_desc"
 )
 
+// Valid reports whether s is a supported sorting.
+func (s RelatedWalletsSorting) Valid() bool {
+	switch s {
+	case RelatedWalletsSortingInflowAsc, RelatedWalletsSortingInflowDesc,
+		RelatedWalletsSortingOutflowAsc, RelatedWalletsSortingOutflowDesc,
+		RelatedWalletsSortingTransactionsAsc, RelatedWalletsSortingTransactionsDesc:
+		return true
+	default:
+		return false
+	}
+}
+
 // RelatedWalletsRequest is used to find wallets that have transacted with the specified wallet.
 type RelatedWalletsRequest struct {
 	// Wallet is the wallet address to find related wallets for (required).
-	Wallet string `json:"wallet"`
+	Wallet string `json:"wallet" query:"-"`
 	// SortCriteria determines how the results are sorted (optional).
-	SortCriteria *RelatedWalletsSorting `json:"sort_criteria,omitempty"`
+	SortCriteria *RelatedWalletsSorting `json:"sort_criteria,omitempty" query:"sort_criteria"`
 }
 
 func (r *RelatedWalletsRequest) GetQueryString() string {
-	if r.SortCriteria != nil {
-		return fmt.Sprintf("sort_criteria=%s", *r.SortCriteria)
-	}
+	return EncodeQuery(r).Encode()
+}
+
+// Validate checks that the wallet is set and the sorting is supported.
+func (r *RelatedWalletsRequest) Validate() error {
+	v := api.Validator{}
+	v.Required(r.Wallet, "Wallet")
+	v.Check(r.SortCriteria == nil || r.SortCriteria.Valid(), "SortCriteria", "is not a supported sorting")
 
-	return ""
+	return v.Err("RelatedWalletsRequest")
 }
 
 // RelatedWalletsResponse contains the list of wallets that have transacted with the queried wallet.
@@ -1,5 +1,11 @@
 package apiv1
 
+import (
+	"fmt"
+
+	"github.com/sealtv/cielogo/api"
+)
+
 type TagType string
 
 const (
@@ -25,12 +31,39 @@ type GetWalletTagsRequest struct {
 	Wallet string `json:"wallet"`
 }
 
+// Validate checks that the wallet is set.
+func (r *GetWalletTagsRequest) Validate() error {
+	v := api.Validator{}
+	v.Required(r.Wallet, "Wallet")
+
+	return v.Err("GetWalletTagsRequest")
+}
+
 type GetWalletTagsResponse struct {
 	Tags []Tag `json:"tags"`
 }
 
+// MaxWalletsTagsWallets is the maximum number of wallets in a GetWalletsTagsRequest.
+const MaxWalletsTagsWallets = 50
+
 type GetWalletsTagsRequest struct {
-	Wallets []string
+	Wallets []string `query:"wallet"`
+}
+
+func (r *GetWalletsTagsRequest) GetQueryString() string {
+	return EncodeQuery(r).Encode()
+}
+
+// Validate checks that between 1 and MaxWalletsTagsWallets wallets are set.
+func (r *GetWalletsTagsRequest) Validate() error {
+	v := api.Validator{}
+	v.Check(len(r.Wallets) > 0, "Wallets", "is required")
+	v.Check(len(r.Wallets) <= MaxWalletsTagsWallets, "Wallets", "must contain at most %d wallets", MaxWalletsTagsWallets)
+	for i, wallet := range r.Wallets {
+		v.Required(wallet, fmt.Sprintf("Wallets[%d]", i))
+	}
+
+	return v.Err("GetWalletsTagsRequest")
 }
 
 type WalletTags struct {
@@ -45,10 +78,24 @@ type Tag struct {
 }
 
 type GetWalletsByTagRequest struct {
-	Tags       []TagType   `json:"tags"`
-	WalletType *WalletType `json:"wallet_type,omitempty"`
-	Limit      *int        `json:"limit,omitempty"`
-	NextObject *string     `json:"next_object,omitempty"`
+	Tags       []TagType   `json:"tags" query:"tags"`
+	WalletType *WalletType `json:"wallet_type,omitempty" query:"wallet_type,omitempty"`
+	Limit      *int        `json:"limit,omitempty" query:"limit"`
+	NextObject *string     `json:"next_object,omitempty" query:"next_object,omitempty"`
+}
+
+func (r *GetWalletsByTagRequest) GetQueryString() string {
+	return EncodeQuery(r).Encode()
+}
+
+// Validate checks that at least one tag is set, the wallet type is known and the limit is positive.
+func (r *GetWalletsByTagRequest) Validate() error {
+	v := api.Validator{}
+	v.Check(len(r.Tags) > 0, "Tags", "is required")
+	v.Check(r.WalletType == nil || *r.WalletType == "" || r.WalletType.Valid(), "WalletType", "is not a known wallet type")
+	v.Check(r.Limit == nil || *r.Limit > 0, "Limit", "must be positive")
+
+	return v.Err("GetWalletsByTagRequest")
 }
 
 type GetWalletsByTagResponse struct {
@@ -1,70 +1,39 @@
 package apiv1
 
 import (
-	"net/url"
-	"strconv"
-	"strings"
-
+	"github.com/sealtv/cielogo/api"
 	"github.com/sealtv/cielogo/api/chains"
 )
 
 // TokensPnLRequest is used to retrieve token profit and loss data for a wallet.
 type TokensPnLRequest struct {
 	// Wallet is the wallet address to get PnL data for (required).
-	Wallet string
+	Wallet string `query:"-"`
 	// Chains filters PnL by specific blockchain chains.
-	Chains []chains.ChainType
+	Chains []chains.ChainType `query:"chains,comma"`
 	// Timeframe specifies the time period for PnL calculation (e.g., "7d", "30d", "all").
-	Timeframe *string
+	Timeframe *string `query:"timeframe"`
 	// NextObject is the pagination cursor from the previous response.
-	NextObject *string
+	NextObject *string `query:"next_object,omitempty"`
 	// CexTransfers includes centralized exchange transfers in PnL calculations when true.
-	CexTransfers *bool
+	CexTransfers *bool `query:"cex_transfers"`
 	// Tokens filters PnL by specific token addresses or symbols.
-	Tokens []string
+	Tokens []string `query:"token,comma"`
 	// ActivePositionsOnly filters for positions with balance > 0 when true.
 	// Set to true to see only currently held tokens, false or nil to see all historical positions.
-	ActivePositionsOnly *bool
+	ActivePositionsOnly *bool `query:"active_positions_only"`
 }
 
 func (r *TokensPnLRequest) GetQueryString() string {
-	values := url.Values{}
-
-	// Add chains if specified
-	if len(r.Chains) > 0 {
-		var chainStrs []string
-		for _, chain := range r.Chains {
-			chainStrs = append(chainStrs, string(chain))
-		}
-		values.Add("chains", strings.Join(chainStrs, ","))
-	}
-
-	// Add timeframe if specified
-	if r.Timeframe != nil {
-		values.Add("timeframe", *r.Timeframe)
-	}
-
-	// Add CexTransfers if specified
-	if r.CexTransfers != nil {
-		values.Add("cex_transfers", strconv.FormatBool(*r.CexTransfers))
-	}
-
-	// Add NextObject if specified
-	if r.NextObject != nil {
-		values.Add("next_object", *r.NextObject)
-	}
-
-	// Add Tokens if specified
-	if len(r.Tokens) > 0 {
-		values.Add("token", strings.Join(r.Tokens, ","))
-	}
+	return EncodeQuery(r).Encode()
+}
 
-	// Add ActivePositionsOnly if specified
-	if r.ActivePositionsOnly != nil {
-		values.Add("active_positions_only", strconv.FormatBool(*r.ActivePositionsOnly))
-	}
+// Validate checks that the wallet is set.
+func (r *TokensPnLRequest) Validate() error {
+	v := api.Validator{}
+	v.Required(r.Wallet, "Wallet")
 
-	return values.Encode()
+	return v.Err("TokensPnLRequest")
 }
 
 type TokensPnLResponse struct {
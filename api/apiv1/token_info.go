@@ -1,7 +1,9 @@
 package apiv1
 
 import (
-	"net/url"
+	"slices"
+
+	"github.com/sealtv/cielogo/api"
 )
 
 // TokenChain represents the blockchain networks supported by token endpoints.
@@ -14,20 +16,41 @@ const (
 	TokenChainHyperevm TokenChain = "hyperevm"
 )
 
+// TokenChains returns the chains supported by token endpoints.
+func TokenChains() []TokenChain {
+	return []TokenChain{TokenChainSolana, TokenChainEthereum, TokenChainBase, TokenChainHyperevm}
+}
+
+// Valid reports whether c is supported by token endpoints.
+func (c TokenChain) Valid() bool {
+	return slices.Contains(TokenChains(), c)
+}
+
+// validateToken checks the chain and token address shared by token requests.
+func validateToken(v *api.Validator, chain TokenChain, tokenAddress string) {
+	v.Check(chain.Valid(), "Chain", "must be one of solana, ethereum, base, hyperevm")
+	v.Required(tokenAddress, "TokenAddress")
+}
+
 // Token Metadata
 
 // TokenMetadataRequest represents a request for token metadata.
 type TokenMetadataRequest struct {
-	Chain        TokenChain
-	TokenAddress string
+	Chain        TokenChain `query:"chain"`
+	TokenAddress string     `query:"token_address"`
 }
 
 // GetQueryString builds the query string for token metadata requests.
 func (r *TokenMetadataRequest) GetQueryString() string {
-	values := url.Values{}
-	values.Add("chain", string(r.Chain))
-	values.Add("token_address", r.TokenAddress)
-	return values.Encode()
+	return EncodeQuery(r).Encode()
+}
+
+// Validate checks the chain and the token address.
+func (r *TokenMetadataRequest) Validate() error {
+	v := api.Validator{}
+	validateToken(&v, r.Chain, r.TokenAddress)
+
+	return v.Err("TokenMetadataRequest")
 }
 
 // TokenMetadataResponse represents detailed metadata for a token including
@@ -51,16 +74,21 @@ type TokenMetadataResponse struct {
 
 // TokenPriceRequest represents a request for current token price.
 type TokenPriceRequest struct {
-	Chain        TokenChain
-	TokenAddress string
+	Chain        TokenChain `query:"chain"`
+	TokenAddress string     `query:"token_address"`
 }
 
 // GetQueryString builds the query string for token price requests.
 func (r *TokenPriceRequest) GetQueryString() string {
-	values := url.Values{}
-	values.Add("chain", string(r.Chain))
-	values.Add("token_address", r.TokenAddress)
-	return values.Encode()
+	return EncodeQuery(r).Encode()
+}
+
+// Validate checks the chain and the token address.
+func (r *TokenPriceRequest) Validate() error {
+	v := api.Validator{}
+	validateToken(&v, r.Chain, r.TokenAddress)
+
+	return v.Err("TokenPriceRequest")
 }
 
 // TokenPriceResponse represents the current price of a token in USD.
@@ -102,16 +130,21 @@ type VolumeBreakdown struct {
 
 // TokenStatsRequest represents a request for comprehensive token statistics.
 type TokenStatsRequest struct {
-	Chain        TokenChain
-	TokenAddress string
+	Chain        TokenChain `query:"chain"`
+	TokenAddress string     `query:"token_address"`
 }
 
 // GetQueryString builds the query string for token stats requests.
 func (r *TokenStatsRequest) GetQueryString() string {
-	values := url.Values{}
-	values.Add("chain", string(r.Chain))
-	values.Add("token_address", r.TokenAddress)
-	return values.Encode()
+	return EncodeQuery(r).Encode()
+}
+
+// Validate checks the chain and the token address.
+func (r *TokenStatsRequest) Validate() error {
+	v := api.Validator{}
+	validateToken(&v, r.Chain, r.TokenAddress)
+
+	return v.Err("TokenStatsRequest")
 }
 
 // TokenStatsResponse represents comprehensive statistics for a token including
@@ -128,17 +161,23 @@ type TokenStatsResponse struct {
 
 // TokenBalanceRequest represents a request for a specific token balance in a wallet.
 type TokenBalanceRequest struct {
-	Wallet       string
-	TokenAddress string
-	Chain        TokenChain
+	Wallet       string     `query:"-"`
+	TokenAddress string     `query:"token_address"`
+	Chain        TokenChain `query:"chain"`
 }
 
 // GetQueryString builds the query string for token balance requests.
 func (r *TokenBalanceRequest) GetQueryString() string {
-	values := url.Values{}
-	values.Add("token_address", r.TokenAddress)
-	values.Add("chain", string(r.Chain))
-	return values.Encode()
+	return EncodeQuery(r).Encode()
+}
+
+// Validate checks the wallet, the chain and the token address.
+func (r *TokenBalanceRequest) Validate() error {
+	v := api.Validator{}
+	v.Required(r.Wallet, "Wallet")
+	validateToken(&v, r.Chain, r.TokenAddress)
+
+	return v.Err("TokenBalanceRequest")
 }
 
 // TokenBalanceResponse represents the balance of a specific token in a wallet.
@@ -1,29 +1,45 @@
 package apiv1
 
+import (
+	"strings"
+
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/chains"
+)
+
 // WalletType represents the blockchain type of a wallet.
-type WalletType string
+type WalletType = chains.WalletType
 
+// Wallet types, see the chains package.
 const (
-	// UnknownWalletType represents an unrecognized wallet type.
-	UnknownWalletType WalletType = "unknown"
-	// EvmWalletType represents EVM-compatible wallets (Ethereum, Polygon, BSC, etc.).
-	EvmWalletType WalletType = "evm"
-	// SolanaWalletType represents Solana blockchain wallets.
-	SolanaWalletType WalletType = "solana"
-	// DydxWalletType represents dYdX protocol wallets.
-	DydxWalletType WalletType = "dydx"
-	// BitcoinWalletType represents Bitcoin blockchain wallets.
-	BitcoinWalletType WalletType = "bitcoin"
-	// TronWalletType represents Tron blockchain wallets.
-	TronWalletType WalletType = "tron"
+	UnknownWalletType = chains.UnknownWalletType
+	EvmWalletType     = chains.EvmWalletType
+	SolanaWalletType  = chains.SolanaWalletType
+	DydxWalletType    = chains.DydxWalletType
+	BitcoinWalletType = chains.BitcoinWalletType
+	TronWalletType    = chains.TronWalletType
+	SuiWalletType     = chains.SuiWalletType
 )
 
 // GetTrackedWalletsRequest is used to retrieve tracked wallets with pagination.
 type GetTrackedWalletsRequest struct {
 	// NextObject is the pagination cursor from the previous response.
-	NextObject *string
+	NextObject *string `query:"next_object,omitempty"`
 	// ListID filters tracked wallets by a specific list ID.
-	ListID *int64
+	ListID *int64 `query:"list_id,omitempty"`
+}
+
+// GetQueryString builds the query string for tracked wallets requests.
+func (r *GetTrackedWalletsRequest) GetQueryString() string {
+	return EncodeQuery(r).Encode()
+}
+
+// Validate checks that the list ID is not negative.
+func (r *GetTrackedWalletsRequest) Validate() error {
+	v := api.Validator{}
+	v.Check(r.ListID == nil || *r.ListID >= 0, "ListID", "must not be negative")
+
+	return v.Err("GetTrackedWalletsRequest")
 }
 
 // AddTrackedWalletRequest is used to add a new wallet to tracking with optional notification settings.
@@ -44,8 +60,10 @@ type AddTrackedWalletRequest struct {
 	MinAmountUSD *float64 `json:"min_amount_usd,omitempty"`
 	// Filters is a list of transaction type filter IDs to apply.
 	Filters []int `json:"filters,omitempty"`
-	// Chains is a list of blockchain chain IDs to monitor.
+	// Chains is a list of numeric Cielo chain IDs to monitor.
 	// Only transactions on these chains will be tracked.
+	// The chains registry does not provide these IDs, see chains.Chain: they are not EIP-155
+	// chain IDs and the API reference does not publish the mapping.
 	Chains []int `json:"chains,omitempty"`
 	// NewTrades enables notifications for new token trades.
 	NewTrades *bool `json:"new_trades,omitempty"`
@@ -55,12 +73,30 @@ type AddTrackedWalletRequest struct {
 	DiscordChannelID *string `json:"discord_channel_id,omitempty"`
 }
 
+// Validate checks that the wallet and the label are set.
+func (r *AddTrackedWalletRequest) Validate() error {
+	v := api.Validator{}
+	v.Required(r.Wallet, "Wallet")
+	v.Required(r.Label, "Label")
+	v.Check(r.MinAmountUSD == nil || *r.MinAmountUSD >= 0, "MinAmountUSD", "must not be negative")
+
+	return v.Err("AddTrackedWalletRequest")
+}
+
 // RemoveTrackedWalletsRequest is used to remove one or more tracked wallets by their IDs.
 type RemoveTrackedWalletsRequest struct {
 	// WalletIDs is the list of wallet IDs to remove from tracking.
 	WalletIDs []int64 `json:"wallet_ids"`
 }
 
+// Validate checks that at least one wallet ID is set.
+func (r *RemoveTrackedWalletsRequest) Validate() error {
+	v := api.Validator{}
+	v.Check(len(r.WalletIDs) > 0, "WalletIDs", "is required")
+
+	return v.Err("RemoveTrackedWalletsRequest")
+}
+
 // GetTrackedWalletsResponse contains the list of tracked wallets with pagination information.
 type GetTrackedWalletsResponse struct {
 	// TrackedWallets is the list of tracked wallets in the current page.
@@ -106,6 +142,15 @@ type UpdateTrackedWalletRequest struct {
 	ListID *int64 `json:"list_id,omitempty"`
 }
 
+// Validate checks that the wallet and the label are set.
+func (r *UpdateTrackedWalletRequest) Validate() error {
+	v := api.Validator{}
+	v.Required(r.Wallet, "Wallet")
+	v.Required(r.Label, "Label")
+
+	return v.Err("UpdateTrackedWalletRequest")
+}
+
 // UpdateTrackedWalletV2Request is used to update a tracked wallet by its address (V2).
 // All fields are optional and only provided fields will be updated (partial update).
 // This is the preferred method for updating specific fields without affecting others.
@@ -128,6 +173,15 @@ type UpdateTrackedWalletV2Request struct {
 	DiscordChannel *string `json:"discord_channel,omitempty"`
 }
 
+// Validate checks that a label, when set, is not empty.
+func (r *UpdateTrackedWalletV2Request) Validate() error {
+	v := api.Validator{}
+	v.Check(r.Label == nil || strings.TrimSpace(*r.Label) != "", "Label", "must not be empty")
+	v.Check(r.MinUSD == nil || *r.MinUSD >= 0, "MinUSD", "must not be negative")
+
+	return v.Err("UpdateTrackedWalletV2Request")
+}
+
 // TelegramBot represents a Telegram bot available for sending wallet notifications.
 // The bot can be used to receive alerts about tracked wallet activity.
 type TelegramBot struct {
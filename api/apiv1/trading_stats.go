@@ -1,6 +1,6 @@
 package apiv1
 
-import "net/url"
+import "github.com/sealtv/cielogo/api"
 
 // TimeframeDays represents the time period for trading statistics queries.
 type TimeframeDays string
@@ -12,25 +12,38 @@ const (
 	TimeframeMax    TimeframeDays = "max"
 )
 
+// Valid reports whether d is a supported timeframe.
+func (d TimeframeDays) Valid() bool {
+	switch d {
+	case Timeframe1Day, Timeframe7Days, Timeframe30Days, TimeframeMax:
+		return true
+	default:
+		return false
+	}
+}
+
 // TradingStatsRequest represents a request for trading performance statistics.
 type TradingStatsRequest struct {
-	Wallet string
+	Wallet string `query:"-"`
 
 	// Days specifies the timeframe for the statistics.
 	// Supported values: 1d, 7d, 30d, max
 	// Defaults to max if not specified.
-	Days *TimeframeDays
+	Days *TimeframeDays `query:"days,omitempty"`
 }
 
 // GetQueryString builds the query string for trading stats requests.
 func (r *TradingStatsRequest) GetQueryString() string {
-	values := url.Values{}
+	return EncodeQuery(r).Encode()
+}
 
-	if r.Days != nil && *r.Days != "" {
-		values.Add("days", string(*r.Days))
-	}
+// Validate checks that the wallet is set and the timeframe is supported.
+func (r *TradingStatsRequest) Validate() error {
+	v := api.Validator{}
+	v.Required(r.Wallet, "Wallet")
+	v.Check(r.Days == nil || *r.Days == "" || r.Days.Valid(), "Days", "must be one of 1d, 7d, 30d, max")
 
-	return values.Encode()
+	return v.Err("TradingStatsRequest")
 }
 
 // TradingStatsResponse represents detailed performance statistics for a wallet's trading activity.
@@ -2,7 +2,9 @@ package apiv1
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/sealtv/cielogo/api/chains"
 )
@@ -33,6 +35,9 @@ const (
 	TxTypeWrap                TxType = "wrap"
 )
 
+// TxEvent is a transaction of the feed. On the wire the common fields and the fields of the
+// typed payload share a single flat JSON object; MarshalJSON produces the same shape, so an
+// encoded TxEvent can be decoded again with UnmarshalJSON.
 type TxEvent struct {
 	Wallet      string           `json:"wallet"`
 	WalletLabel string           `json:"wallet_label"`
@@ -43,9 +48,71 @@ type TxEvent struct {
 	Timestamp   int64            `json:"timestamp"`
 	Block       int              `json:"block"`
 	Data        TransactionEvent `json:"-"`
+	// Raw is the JSON object the event was decoded from, kept only with TxDecodeOptions.KeepRaw.
+	Raw json.RawMessage `json:"-"`
 }
 
+// txEventJSON is TxEvent without its methods: it encodes only the common fields.
+type txEventJSON TxEvent
+
+// MarshalJSON encodes the event in the flat wire format: the fields of Data and the common fields
+// at the same level. Non-zero common fields of the TxEvent take precedence over those of Data.
+func (t TxEvent) MarshalJSON() ([]byte, error) {
+	common, err := json.Marshal(txEventJSON(t))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tx event: %w", err)
+	}
+
+	if t.Data == nil {
+		return common, nil
+	}
+
+	data, err := json.Marshal(t.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tx event %q data: %w", string(t.TxType), err)
+	}
+
+	return mergeTxEventFields(data, common)
+}
+
+// mergeTxEventFields merges two JSON objects. Fields of overlay replace those of base unless
+// they are zero values, so that an unset common field never hides the one of the payload.
+func mergeTxEventFields(base, overlay []byte) ([]byte, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(base, &fields); err != nil {
+		return nil, fmt.Errorf("failed to merge tx event fields: %w", err)
+	}
+
+	var overlayFields map[string]json.RawMessage
+	if err := json.Unmarshal(overlay, &overlayFields); err != nil {
+		return nil, fmt.Errorf("failed to merge tx event fields: %w", err)
+	}
+
+	for key, value := range overlayFields {
+		if _, ok := fields[key]; !ok || !isZeroJSON(value) {
+			fields[key] = value
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+func isZeroJSON(value json.RawMessage) bool {
+	switch string(value) {
+	case "null", `""`, "0", "false":
+		return true
+	default:
+		return false
+	}
+}
+
+// UnmarshalJSON decodes the flat wire format with the zero TxDecodeOptions.
 func (t *TxEvent) UnmarshalJSON(data []byte) error {
+	return TxDecodeOptions{}.UnmarshalTxEvent(data, t)
+}
+
+// UnmarshalTxEvent decodes a transaction in the flat wire format into t.
+func (o TxDecodeOptions) UnmarshalTxEvent(data []byte, t *TxEvent) error {
 	tmp := struct {
 		Wallet      string           `json:"wallet"`
 		WalletLabel string           `json:"wallet_label"`
@@ -70,7 +137,37 @@ func (t *TxEvent) UnmarshalJSON(data []byte) error {
 	t.Timestamp = tmp.Timestamp
 	t.Block = tmp.Block
 
+	t.Raw = nil
+	if o.KeepRaw {
+		t.Raw = append(json.RawMessage(nil), data...)
+	}
+
 	t.Data = createTransactionEventByType(tmp.TxType)
+	if t.Data == nil {
+		unknown := &UnknownEvent{
+			Wallet:      tmp.Wallet,
+			WalletLabel: tmp.WalletLabel,
+			TxHash:      tmp.TxHash,
+			TxType:      tmp.TxType,
+			Chain:       tmp.Chain,
+			Index:       tmp.Index,
+			Timestamp:   tmp.Timestamp,
+			Block:       tmp.Block,
+			Raw:         append(json.RawMessage(nil), data...),
+		}
+
+		if o.OnUnknownType != nil {
+			o.OnUnknownType(unknown)
+		}
+
+		if o.StrictTypes {
+			return fmt.Errorf("failed to unmarshal tx event: %w: %q", ErrUnknownTxType, string(tmp.TxType))
+		}
+
+		t.Data = unknown
+
+		return nil
+	}
 
 	if err := json.Unmarshal(data, t.Data); err != nil {
 		return fmt.Errorf("failed to unmarshal tx event %q data: %w", string(t.TxType), err)
@@ -79,6 +176,23 @@ func (t *TxEvent) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// ExplorerURL returns the block-explorer page of the transaction, or "" if the chain is unknown.
+// Use chains.SetExplorer to link to another explorer.
+func (t TxEvent) ExplorerURL() string {
+	return t.Chain.TxURL(t.TxHash)
+}
+
+// WalletExplorerURL returns the block-explorer page of the wallet, or "" if the chain is unknown.
+func (t TxEvent) WalletExplorerURL() string {
+	return t.Chain.AddressURL(t.Wallet)
+}
+
+// TokenExplorerURL returns the block-explorer page of a token on the chain of the transaction,
+// or "" if the chain is unknown or its explorer has no token pages.
+func (t TxEvent) TokenExplorerURL(tokenAddress string) string {
+	return t.Chain.TokenURL(tokenAddress)
+}
+
 // createTransactionEventByType creates the appropriate TransactionEvent based on the transaction type.
 func createTransactionEventByType(txType TxType) TransactionEvent {
 	switch txType {
@@ -127,8 +241,72 @@ func createTransactionEventByType(txType TxType) TransactionEvent {
 	}
 }
 
+// ErrUnknownTxType is returned when decoding a transaction of an unknown type in strict mode.
+var ErrUnknownTxType = errors.New("cielo: unknown transaction type")
+
+// TxDecodeOptions controls how transactions are decoded. With the zero value, which
+// TxEvent.UnmarshalJSON uses, transaction types this version of the library does not know are
+// decoded into an *UnknownEvent, so a new server-side type never fails a feed page or a
+// WebSocket message. The client applies its options with cielogo.WithStrictTxTypes,
+// cielogo.WithUnknownTxTypeHandler and cielogo.WithRawTxEvents.
+type TxDecodeOptions struct {
+	// StrictTypes makes decoding a transaction of an unknown type fail with ErrUnknownTxType.
+	StrictTypes bool
+	// OnUnknownType, if set, is called with every transaction of an unknown type, in both lenient
+	// and strict mode, e.g. to log or count them. It may be called concurrently.
+	OnUnknownType func(*UnknownEvent)
+	// KeepRaw keeps a copy of the JSON object of every transaction in TxEvent.Raw.
+	KeepRaw bool
+}
+
+// UnmarshalFeedResponse decodes a feed page into r, decoding its transactions with the options.
+func (o TxDecodeOptions) UnmarshalFeedResponse(data []byte, r *FeedResponse) error {
+	tmp := struct {
+		Items  []json.RawMessage `json:"items"`
+		Paging Pagination        `json:"paging"`
+	}{}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return fmt.Errorf("failed to unmarshal feed response: %w", err)
+	}
+
+	r.Paging = tmp.Paging
+	r.Items = nil
+	if tmp.Items != nil {
+		r.Items = make([]TxEvent, len(tmp.Items))
+	}
+
+	for i, item := range tmp.Items {
+		if err := o.UnmarshalTxEvent(item, &r.Items[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TransactionEvent is the typed payload of a TxEvent. Every event type gives access to the
+// fields it shares with the others, so generic filtering, sorting and display code does not
+// need a type switch.
 type TransactionEvent interface {
+	// GetType returns the transaction type.
 	GetType() TxType
+	// GetWallet returns the address of the wallet the transaction belongs to.
+	GetWallet() string
+	// GetWalletLabel returns the label of the wallet, if any.
+	GetWalletLabel() string
+	// GetTxHash returns the transaction hash.
+	GetTxHash() string
+	// GetChain returns the chain of the transaction.
+	GetChain() chains.ChainType
+	// GetTime returns the block time in UTC, or the zero time if the timestamp is missing.
+	GetTime() time.Time
+	// GetUsdValue returns the main USD value of the transaction: the amount moved for swaps,
+	// transfers and the like, the price for NFT trades, both legs for LP events.
+	GetUsdValue() float64
+	// GetCounterparties returns the distinct addresses the wallet interacted with, without the wallet itself.
+	GetCounterparties() []string
+	// GetTokenAddresses returns the distinct addresses of the tokens and NFT collections involved.
+	GetTokenAddresses() []string
 }
 
 type BridgeEvent struct {
@@ -723,3 +901,44 @@ type WrapEvent struct {
 func (w *WrapEvent) GetType() TxType {
 	return TxTypeWrap
 }
+
+// UnknownEvent holds a transaction whose type this version of the library does not know.
+// It keeps the common fields and the raw JSON object, which can be decoded by the caller.
+type UnknownEvent struct {
+	Wallet      string           `json:"wallet"`
+	WalletLabel string           `json:"wallet_label"`
+	TxHash      string           `json:"tx_hash"`
+	TxType      TxType           `json:"tx_type"`
+	Chain       chains.ChainType `json:"chain"`
+	Index       int              `json:"index"`
+	Timestamp   int64            `json:"timestamp"`
+	Block       int              `json:"block"`
+	// Raw is the transaction JSON object as received.
+	Raw json.RawMessage `json:"-"`
+}
+
+func (u *UnknownEvent) GetType() TxType {
+	return u.TxType
+}
+
+// unknownEventJSON is UnknownEvent without its methods.
+type unknownEventJSON UnknownEvent
+
+// MarshalJSON encodes the raw JSON object with the common fields of u applied on top of it.
+func (u *UnknownEvent) MarshalJSON() ([]byte, error) {
+	common, err := json.Marshal((*unknownEventJSON)(u))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal unknown tx event %q: %w", string(u.TxType), err)
+	}
+
+	if len(u.Raw) == 0 {
+		return common, nil
+	}
+
+	data, err := mergeTxEventFields(u.Raw, common)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal unknown tx event %q: %w", string(u.TxType), err)
+	}
+
+	return data, nil
+}
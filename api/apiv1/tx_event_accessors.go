@@ -0,0 +1,767 @@
+package apiv1
+
+import (
+	"slices"
+	"time"
+
+	"github.com/sealtv/cielogo/api/address"
+	"github.com/sealtv/cielogo/api/chains"
+)
+
+// BridgeEvent
+
+func (b *BridgeEvent) GetWallet() string {
+	return b.Wallet
+}
+
+func (b *BridgeEvent) GetWalletLabel() string {
+	return b.WalletLabel
+}
+
+func (b *BridgeEvent) GetTxHash() string {
+	return b.TxHash
+}
+
+func (b *BridgeEvent) GetChain() chains.ChainType {
+	return b.Chain
+}
+
+func (b *BridgeEvent) GetTime() time.Time {
+	return unixTime(b.Timestamp)
+}
+
+func (b *BridgeEvent) GetUsdValue() float64 {
+	return b.AmountUSD
+}
+
+func (b *BridgeEvent) GetCounterparties() []string {
+	return counterparties(b.Wallet, b.From, b.To)
+}
+
+func (b *BridgeEvent) GetTokenAddresses() []string {
+	return addresses(b.TokenAddress)
+}
+
+// LendingEvent
+
+func (l *LendingEvent) GetWallet() string {
+	return l.Wallet
+}
+
+func (l *LendingEvent) GetWalletLabel() string {
+	return l.WalletLabel
+}
+
+func (l *LendingEvent) GetTxHash() string {
+	return l.TxHash
+}
+
+func (l *LendingEvent) GetChain() chains.ChainType {
+	return l.Chain
+}
+
+func (l *LendingEvent) GetTime() time.Time {
+	return unixTime(l.Timestamp)
+}
+
+func (l *LendingEvent) GetUsdValue() float64 {
+	return l.AmountUSD
+}
+
+func (l *LendingEvent) GetCounterparties() []string {
+	return counterparties(l.Wallet, l.From)
+}
+
+func (l *LendingEvent) GetTokenAddresses() []string {
+	return addresses(l.Address)
+}
+
+// LpEvent
+
+func (l *LpEvent) GetWallet() string {
+	return l.Wallet
+}
+
+func (l *LpEvent) GetWalletLabel() string {
+	return l.WalletLabel
+}
+
+func (l *LpEvent) GetTxHash() string {
+	return l.TxHash
+}
+
+func (l *LpEvent) GetChain() chains.ChainType {
+	return l.Chain
+}
+
+func (l *LpEvent) GetTime() time.Time {
+	return unixTime(l.Timestamp)
+}
+
+func (l *LpEvent) GetUsdValue() float64 {
+	return l.Token0AmountUSD + l.Token1AmountUSD
+}
+
+func (l *LpEvent) GetCounterparties() []string {
+	return counterparties(l.Wallet, l.From)
+}
+
+func (l *LpEvent) GetTokenAddresses() []string {
+	return addresses(l.Token0Address, l.Token1Address)
+}
+
+// NftLendingEvent
+
+func (n *NftLendingEvent) GetWallet() string {
+	return n.Wallet
+}
+
+func (n *NftLendingEvent) GetWalletLabel() string {
+	return n.WalletLabel
+}
+
+func (n *NftLendingEvent) GetTxHash() string {
+	return n.TxHash
+}
+
+func (n *NftLendingEvent) GetChain() chains.ChainType {
+	return n.Chain
+}
+
+func (n *NftLendingEvent) GetTime() time.Time {
+	return unixTime(n.Timestamp)
+}
+
+func (n *NftLendingEvent) GetUsdValue() float64 {
+	return n.PriceUSD
+}
+
+func (n *NftLendingEvent) GetCounterparties() []string {
+	return counterparties(n.Wallet, n.From, n.To)
+}
+
+func (n *NftLendingEvent) GetTokenAddresses() []string {
+	return addresses(n.NftAddress, n.CurrencyAddress)
+}
+
+// NftMintEvent
+
+func (n *NftMintEvent) GetWallet() string {
+	return n.Wallet
+}
+
+func (n *NftMintEvent) GetWalletLabel() string {
+	return n.WalletLabel
+}
+
+func (n *NftMintEvent) GetTxHash() string {
+	return n.TxHash
+}
+
+func (n *NftMintEvent) GetChain() chains.ChainType {
+	return n.Chain
+}
+
+func (n *NftMintEvent) GetTime() time.Time {
+	return unixTime(n.Timestamp)
+}
+
+func (n *NftMintEvent) GetUsdValue() float64 {
+	return n.ValueUsd
+}
+
+func (n *NftMintEvent) GetCounterparties() []string {
+	return counterparties(n.Wallet, n.From, n.To)
+}
+
+func (n *NftMintEvent) GetTokenAddresses() []string {
+	return addresses(n.ContractAddress)
+}
+
+// NftTradeEvent
+
+func (n *NftTradeEvent) GetWallet() string {
+	return n.Wallet
+}
+
+func (n *NftTradeEvent) GetWalletLabel() string {
+	return n.WalletLabel
+}
+
+func (n *NftTradeEvent) GetTxHash() string {
+	return n.TxHash
+}
+
+func (n *NftTradeEvent) GetChain() chains.ChainType {
+	return n.Chain
+}
+
+func (n *NftTradeEvent) GetTime() time.Time {
+	return unixTime(n.Timestamp)
+}
+
+func (n *NftTradeEvent) GetUsdValue() float64 {
+	return n.PriceUsd
+}
+
+func (n *NftTradeEvent) GetCounterparties() []string {
+	return counterparties(n.Wallet, n.From, n.To, n.Buyer, n.Seller)
+}
+
+func (n *NftTradeEvent) GetTokenAddresses() []string {
+	return addresses(n.NftAddress, n.Token)
+}
+
+// NftTransferEvent
+
+func (n *NftTransferEvent) GetWallet() string {
+	return n.Wallet
+}
+
+func (n *NftTransferEvent) GetWalletLabel() string {
+	return n.WalletLabel
+}
+
+func (n *NftTransferEvent) GetTxHash() string {
+	return n.TxHash
+}
+
+func (n *NftTransferEvent) GetChain() chains.ChainType {
+	return n.Chain
+}
+
+func (n *NftTransferEvent) GetTime() time.Time {
+	return unixTime(n.Timestamp)
+}
+
+// GetUsdValue returns zero: the event carries no USD amount.
+func (n *NftTransferEvent) GetUsdValue() float64 {
+	return 0
+}
+
+func (n *NftTransferEvent) GetCounterparties() []string {
+	return counterparties(n.Wallet, n.From, n.To)
+}
+
+func (n *NftTransferEvent) GetTokenAddresses() []string {
+	return addresses(n.ConstractAddress)
+}
+
+// SwapEvent
+
+func (s *SwapEvent) GetWallet() string {
+	return s.Wallet
+}
+
+func (s *SwapEvent) GetWalletLabel() string {
+	return s.WalletLabel
+}
+
+func (s *SwapEvent) GetTxHash() string {
+	return s.TxHash
+}
+
+func (s *SwapEvent) GetChain() chains.ChainType {
+	return s.Chain
+}
+
+func (s *SwapEvent) GetTime() time.Time {
+	return unixTime(s.Timestamp)
+}
+
+func (s *SwapEvent) GetUsdValue() float64 {
+	return s.AmountUsd
+}
+
+func (s *SwapEvent) GetCounterparties() []string {
+	return counterparties(s.Wallet, s.From, s.To)
+}
+
+func (s *SwapEvent) GetTokenAddresses() []string {
+	return addresses(s.TokenAddress)
+}
+
+// TransferEvent
+
+func (t *TransferEvent) GetWallet() string {
+	return t.Wallet
+}
+
+func (t *TransferEvent) GetWalletLabel() string {
+	return t.WalletLabel
+}
+
+func (t *TransferEvent) GetTxHash() string {
+	return t.TxHash
+}
+
+func (t *TransferEvent) GetChain() chains.ChainType {
+	return t.Chain
+}
+
+func (t *TransferEvent) GetTime() time.Time {
+	return unixTime(t.Timestamp)
+}
+
+func (t *TransferEvent) GetUsdValue() float64 {
+	return t.AmountUsd
+}
+
+func (t *TransferEvent) GetCounterparties() []string {
+	return counterparties(t.Wallet, t.From, t.To)
+}
+
+func (t *TransferEvent) GetTokenAddresses() []string {
+	return addresses(t.ContractAddress)
+}
+
+// ContractCreationEvent
+
+func (c *ContractCreationEvent) GetWallet() string {
+	return c.Wallet
+}
+
+func (c *ContractCreationEvent) GetWalletLabel() string {
+	return c.WalletLabel
+}
+
+func (c *ContractCreationEvent) GetTxHash() string {
+	return c.TxHash
+}
+
+func (c *ContractCreationEvent) GetChain() chains.ChainType {
+	return c.Chain
+}
+
+func (c *ContractCreationEvent) GetTime() time.Time {
+	return unixTime(c.Timestamp)
+}
+
+func (c *ContractCreationEvent) GetUsdValue() float64 {
+	return c.AmountUsd
+}
+
+func (c *ContractCreationEvent) GetCounterparties() []string {
+	return counterparties(c.Wallet, c.From)
+}
+
+func (c *ContractCreationEvent) GetTokenAddresses() []string {
+	return nil
+}
+
+// ContractInteractionEvent
+
+func (c *ContractInteractionEvent) GetWallet() string {
+	return c.Wallet
+}
+
+func (c *ContractInteractionEvent) GetWalletLabel() string {
+	return c.WalletLabel
+}
+
+func (c *ContractInteractionEvent) GetTxHash() string {
+	return c.TxHash
+}
+
+func (c *ContractInteractionEvent) GetChain() chains.ChainType {
+	return c.Chain
+}
+
+func (c *ContractInteractionEvent) GetTime() time.Time {
+	return unixTime(c.Timestamp)
+}
+
+// GetUsdValue returns zero: the event carries no USD amount.
+func (c *ContractInteractionEvent) GetUsdValue() float64 {
+	return 0
+}
+
+func (c *ContractInteractionEvent) GetCounterparties() []string {
+	return counterparties(c.Wallet, c.From, c.To, c.ContractAddress)
+}
+
+func (c *ContractInteractionEvent) GetTokenAddresses() []string {
+	return nil
+}
+
+// FlashloanEvent
+
+func (f *FlashloanEvent) GetWallet() string {
+	return f.Wallet
+}
+
+func (f *FlashloanEvent) GetWalletLabel() string {
+	return f.WalletLabel
+}
+
+func (f *FlashloanEvent) GetTxHash() string {
+	return f.TxHash
+}
+
+func (f *FlashloanEvent) GetChain() chains.ChainType {
+	return f.Chain
+}
+
+func (f *FlashloanEvent) GetTime() time.Time {
+	return unixTime(f.Timestamp)
+}
+
+func (f *FlashloanEvent) GetUsdValue() float64 {
+	return f.AmountUsd
+}
+
+func (f *FlashloanEvent) GetCounterparties() []string {
+	return counterparties(f.Wallet, f.From, f.To)
+}
+
+func (f *FlashloanEvent) GetTokenAddresses() []string {
+	return addresses(f.Address)
+}
+
+// NftLiquidationEvent
+
+func (n *NftLiquidationEvent) GetWallet() string {
+	return n.Wallet
+}
+
+func (n *NftLiquidationEvent) GetWalletLabel() string {
+	return n.WalletLabel
+}
+
+func (n *NftLiquidationEvent) GetTxHash() string {
+	return n.TxHash
+}
+
+func (n *NftLiquidationEvent) GetChain() chains.ChainType {
+	return n.Chain
+}
+
+func (n *NftLiquidationEvent) GetTime() time.Time {
+	return unixTime(n.Timestamp)
+}
+
+func (n *NftLiquidationEvent) GetUsdValue() float64 {
+	return n.PriceUsd
+}
+
+func (n *NftLiquidationEvent) GetCounterparties() []string {
+	return counterparties(n.Wallet, n.From, n.To)
+}
+
+func (n *NftLiquidationEvent) GetTokenAddresses() []string {
+	return addresses(n.NftAddress, n.CurrencyAddress)
+}
+
+// NftSweepEvent
+
+func (n *NftSweepEvent) GetWallet() string {
+	return n.Wallet
+}
+
+func (n *NftSweepEvent) GetWalletLabel() string {
+	return n.WalletLabel
+}
+
+func (n *NftSweepEvent) GetTxHash() string {
+	return n.TxHash
+}
+
+func (n *NftSweepEvent) GetChain() chains.ChainType {
+	return n.Chain
+}
+
+func (n *NftSweepEvent) GetTime() time.Time {
+	return unixTime(n.Timestamp)
+}
+
+func (n *NftSweepEvent) GetUsdValue() float64 {
+	return n.PriceUsd
+}
+
+func (n *NftSweepEvent) GetCounterparties() []string {
+	return counterparties(n.Wallet, n.From, n.To, n.Buyer, n.Seller)
+}
+
+func (n *NftSweepEvent) GetTokenAddresses() []string {
+	return addresses(n.NftAddress, n.Token)
+}
+
+// OptionEvent
+
+func (o *OptionEvent) GetWallet() string {
+	return o.Wallet
+}
+
+func (o *OptionEvent) GetWalletLabel() string {
+	return o.WalletLabel
+}
+
+func (o *OptionEvent) GetTxHash() string {
+	return o.TxHash
+}
+
+func (o *OptionEvent) GetChain() chains.ChainType {
+	return o.Chain
+}
+
+func (o *OptionEvent) GetTime() time.Time {
+	return unixTime(o.Timestamp)
+}
+
+func (o *OptionEvent) GetUsdValue() float64 {
+	return o.Amount * o.OptionPriceUsd
+}
+
+func (o *OptionEvent) GetCounterparties() []string {
+	return counterparties(o.Wallet, o.From, o.To)
+}
+
+func (o *OptionEvent) GetTokenAddresses() []string {
+	return nil
+}
+
+// PerpEvent
+
+func (p *PerpEvent) GetWallet() string {
+	return p.Wallet
+}
+
+func (p *PerpEvent) GetWalletLabel() string {
+	return p.WalletLabel
+}
+
+func (p *PerpEvent) GetTxHash() string {
+	return p.TxHash
+}
+
+func (p *PerpEvent) GetChain() chains.ChainType {
+	return p.Chain
+}
+
+func (p *PerpEvent) GetTime() time.Time {
+	return unixTime(p.Timestamp)
+}
+
+func (p *PerpEvent) GetUsdValue() float64 {
+	return p.AmountUsd
+}
+
+func (p *PerpEvent) GetCounterparties() []string {
+	return counterparties(p.Wallet, p.From, p.To)
+}
+
+func (p *PerpEvent) GetTokenAddresses() []string {
+	return addresses(p.BaseTokenAddress, p.Token0Address, p.Token1Address)
+}
+
+// RewardEvent
+
+func (r *RewardEvent) GetWallet() string {
+	return r.Wallet
+}
+
+func (r *RewardEvent) GetWalletLabel() string {
+	return r.WalletLabel
+}
+
+func (r *RewardEvent) GetTxHash() string {
+	return r.TxHash
+}
+
+func (r *RewardEvent) GetChain() chains.ChainType {
+	return r.Chain
+}
+
+func (r *RewardEvent) GetTime() time.Time {
+	return unixTime(r.Timestamp)
+}
+
+func (r *RewardEvent) GetUsdValue() float64 {
+	return r.AmountUsd
+}
+
+func (r *RewardEvent) GetCounterparties() []string {
+	return counterparties(r.Wallet, r.From)
+}
+
+func (r *RewardEvent) GetTokenAddresses() []string {
+	return addresses(r.Address)
+}
+
+// StakingEvent
+
+func (s *StakingEvent) GetWallet() string {
+	return s.Wallet
+}
+
+func (s *StakingEvent) GetWalletLabel() string {
+	return s.WalletLabel
+}
+
+func (s *StakingEvent) GetTxHash() string {
+	return s.TxHash
+}
+
+func (s *StakingEvent) GetChain() chains.ChainType {
+	return s.Chain
+}
+
+func (s *StakingEvent) GetTime() time.Time {
+	return unixTime(s.Timestamp)
+}
+
+func (s *StakingEvent) GetUsdValue() float64 {
+	return s.AmountUsd
+}
+
+func (s *StakingEvent) GetCounterparties() []string {
+	return counterparties(s.Wallet, s.From, s.To)
+}
+
+func (s *StakingEvent) GetTokenAddresses() []string {
+	return addresses(s.ContractAddress)
+}
+
+// SudoPoolEvent
+
+func (s *SudoPoolEvent) GetWallet() string {
+	return s.Wallet
+}
+
+func (s *SudoPoolEvent) GetWalletLabel() string {
+	return s.WalletLabel
+}
+
+func (s *SudoPoolEvent) GetTxHash() string {
+	return s.TxHash
+}
+
+func (s *SudoPoolEvent) GetChain() chains.ChainType {
+	return s.Chain
+}
+
+func (s *SudoPoolEvent) GetTime() time.Time {
+	return unixTime(s.Timestamp)
+}
+
+func (s *SudoPoolEvent) GetUsdValue() float64 {
+	return s.Token0AmountUsd
+}
+
+func (s *SudoPoolEvent) GetCounterparties() []string {
+	return counterparties(s.Wallet, s.From, s.To)
+}
+
+func (s *SudoPoolEvent) GetTokenAddresses() []string {
+	return addresses(s.NftAddress, s.Token0Address)
+}
+
+// WrapEvent
+
+func (w *WrapEvent) GetWallet() string {
+	return w.Wallet
+}
+
+func (w *WrapEvent) GetWalletLabel() string {
+	return w.WalletLabel
+}
+
+func (w *WrapEvent) GetTxHash() string {
+	return w.TxHash
+}
+
+func (w *WrapEvent) GetChain() chains.ChainType {
+	return w.Chain
+}
+
+func (w *WrapEvent) GetTime() time.Time {
+	return unixTime(w.Timestamp)
+}
+
+func (w *WrapEvent) GetUsdValue() float64 {
+	return w.AmountUsd
+}
+
+func (w *WrapEvent) GetCounterparties() []string {
+	return counterparties(w.Wallet, w.From, w.To)
+}
+
+func (w *WrapEvent) GetTokenAddresses() []string {
+	return addresses(w.ContractAddress)
+}
+
+// UnknownEvent
+
+func (u *UnknownEvent) GetWallet() string {
+	return u.Wallet
+}
+
+func (u *UnknownEvent) GetWalletLabel() string {
+	return u.WalletLabel
+}
+
+func (u *UnknownEvent) GetTxHash() string {
+	return u.TxHash
+}
+
+func (u *UnknownEvent) GetChain() chains.ChainType {
+	return u.Chain
+}
+
+func (u *UnknownEvent) GetTime() time.Time {
+	return unixTime(u.Timestamp)
+}
+
+// GetUsdValue returns zero: the event carries no USD amount.
+func (u *UnknownEvent) GetUsdValue() float64 {
+	return 0
+}
+
+func (u *UnknownEvent) GetCounterparties() []string {
+	return nil
+}
+
+func (u *UnknownEvent) GetTokenAddresses() []string {
+	return nil
+}
+
+// unixTime converts a timestamp in seconds to a UTC time, the zero time for a zero timestamp.
+func unixTime(timestamp int64) time.Time {
+	if timestamp == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(timestamp, 0).UTC()
+}
+
+// counterparties returns the distinct non-empty addresses other than the wallet itself.
+func counterparties(wallet string, candidates ...string) []string {
+	var out []string
+	for _, a := range candidates {
+		if !address.Equal(a, wallet) {
+			out = appendAddress(out, a)
+		}
+	}
+
+	return out
+}
+
+// addresses returns the distinct non-empty addresses, in order.
+func addresses(candidates ...string) []string {
+	var out []string
+	for _, a := range candidates {
+		out = appendAddress(out, a)
+	}
+
+	return out
+}
+
+// appendAddress appends a to out unless it is empty or already there. Addresses are compared
+// with address.Equal, so EVM addresses match in any case while base58 addresses stay case-sensitive.
+func appendAddress(out []string, a string) []string {
+	if a == "" || slices.ContainsFunc(out, func(b string) bool { return address.Equal(a, b) }) {
+		return out
+	}
+
+	return append(out, a)
+}
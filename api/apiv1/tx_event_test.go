@@ -0,0 +1,266 @@
+package apiv1_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/api/chains"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxEvent_ExplorerURL(t *testing.T) {
+	event := apiv1.TxEvent{Wallet: "0x1", TxHash: "0xabc", Chain: chains.Arbitrum}
+
+	assert.Equal(t, "https://arbiscan.io/tx/0xabc", event.ExplorerURL())
+	assert.Equal(t, "https://arbiscan.io/address/0x1", event.WalletExplorerURL())
+	assert.Equal(t, "https://arbiscan.io/token/0x2", event.TokenExplorerURL("0x2"))
+
+	assert.Empty(t, apiv1.TxEvent{TxHash: "0xabc", Chain: "cosmos"}.ExplorerURL())
+}
+
+const unknownTxEventJSON = `{"wallet":"0x1","wallet_label":"whale","tx_hash":"0xabc","tx_type":"restake",` +
+	`"chain":"ethereum","index":2,"timestamp":1700000000,"block":42,"operator":"0x9"}`
+
+func TestTxEvent_UnmarshalJSON_UnknownType(t *testing.T) {
+	var event apiv1.TxEvent
+	require.NoError(t, json.Unmarshal([]byte(unknownTxEventJSON), &event))
+
+	assert.Equal(t, apiv1.TxType("restake"), event.TxType)
+	unknown, ok := event.Data.(*apiv1.UnknownEvent)
+	require.True(t, ok)
+	assert.Equal(t, apiv1.TxType("restake"), unknown.GetType())
+	assert.Equal(t, "0x1", unknown.Wallet)
+	assert.Equal(t, "whale", unknown.WalletLabel)
+	assert.Equal(t, chains.Ethereum, unknown.Chain)
+	assert.Equal(t, int64(1700000000), unknown.Timestamp)
+	assert.Equal(t, 42, unknown.Block)
+	assert.JSONEq(t, unknownTxEventJSON, string(unknown.Raw))
+
+	var ws apiv1.WSEvent
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"tx","data":`+unknownTxEventJSON+`}`), &ws))
+	assert.IsType(t, &apiv1.UnknownEvent{}, ws.Data.(apiv1.TxEvent).Data)
+}
+
+func TestTxDecodeOptions_StrictTypes(t *testing.T) {
+	var reported []apiv1.TxType
+	opts := apiv1.TxDecodeOptions{
+		StrictTypes:   true,
+		OnUnknownType: func(e *apiv1.UnknownEvent) { reported = append(reported, e.TxType) },
+	}
+
+	var event apiv1.TxEvent
+	err := opts.UnmarshalTxEvent([]byte(unknownTxEventJSON), &event)
+	assert.ErrorIs(t, err, apiv1.ErrUnknownTxType)
+
+	var ws apiv1.WSEvent
+	err = opts.UnmarshalWSEvent([]byte(`{"type":"tx","data":`+unknownTxEventJSON+`}`), &ws)
+	assert.ErrorIs(t, err, apiv1.ErrUnknownTxType)
+
+	var feed apiv1.FeedResponse
+	err = opts.UnmarshalFeedResponse([]byte(`{"items":[`+swapTxEventJSON+`,`+unknownTxEventJSON+`]}`), &feed)
+	assert.ErrorIs(t, err, apiv1.ErrUnknownTxType)
+
+	require.NoError(t, opts.UnmarshalTxEvent([]byte(`{"tx_type":"swap","token0_symbol":"PEPE"}`), &event))
+	assert.Equal(t, []apiv1.TxType{"restake", "restake", "restake"}, reported)
+
+	require.NoError(t, json.Unmarshal([]byte(unknownTxEventJSON), &event), "json.Unmarshal uses the zero options")
+	assert.Len(t, reported, 3)
+}
+
+func TestTxDecodeOptions_UnmarshalFeedResponse(t *testing.T) {
+	var reported int
+	opts := apiv1.TxDecodeOptions{OnUnknownType: func(*apiv1.UnknownEvent) { reported++ }}
+
+	var feed apiv1.FeedResponse
+	data := `{"items":[` + swapTxEventJSON + `,` + unknownTxEventJSON + `],"paging":{"total_rows_in_page":2,"has_next_page":true,"next_object":"n1"}}`
+	require.NoError(t, opts.UnmarshalFeedResponse([]byte(data), &feed))
+
+	require.Len(t, feed.Items, 2)
+	assert.IsType(t, &apiv1.SwapEvent{}, feed.Items[0].Data)
+	assert.IsType(t, &apiv1.UnknownEvent{}, feed.Items[1].Data)
+	assert.Equal(t, 1, reported)
+
+	var want apiv1.FeedResponse
+	require.NoError(t, json.Unmarshal([]byte(data), &want))
+	assert.Equal(t, want, feed)
+}
+
+const swapTxEventJSON = `{"wallet":"0x1","wallet_label":"whale","tx_hash":"0xabc","tx_type":"swap","chain":"ethereum",` +
+	`"index":1,"timestamp":1700000000,"block":42,"from":"0x1","to":"0x2","from_label":"","to_label":"Uniswap",` +
+	`"token_address":"0xa","token_name":"Pepe","token_symbol":"PEPE","amount":100,"amount_usd":3000,` +
+	`"from_chain":"","to_chain":"","platform":"uniswap","price":30,"type":"buy"}`
+
+func TestTxEvent_MarshalJSON_RoundTrip(t *testing.T) {
+	var event apiv1.TxEvent
+	require.NoError(t, json.Unmarshal([]byte(swapTxEventJSON), &event))
+	require.IsType(t, &apiv1.SwapEvent{}, event.Data)
+	assert.Nil(t, event.Raw)
+
+	encoded, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	var decoded apiv1.TxEvent
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, event, decoded)
+
+	swap := event.Data.(*apiv1.SwapEvent)
+	assert.Equal(t, "PEPE", swap.TokenSymbol)
+	assert.Equal(t, 3000.0, swap.AmountUsd)
+}
+
+func TestTxEvent_MarshalJSON_CommonFieldsTakePrecedence(t *testing.T) {
+	event := apiv1.TxEvent{
+		Wallet: "0x1",
+		TxType: apiv1.TxTypeSwap,
+		Chain:  chains.Base,
+		Data:   &apiv1.SwapEvent{TxHash: "0xabc", Chain: chains.Ethereum, TokenSymbol: "ETH"},
+	}
+
+	encoded, err := json.Marshal(&event)
+	require.NoError(t, err)
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(encoded, &fields))
+	assert.Equal(t, "0x1", fields["wallet"])
+	assert.Equal(t, "0xabc", fields["tx_hash"])
+	assert.Equal(t, "base", fields["chain"])
+	assert.Equal(t, "swap", fields["tx_type"])
+	assert.Equal(t, "ETH", fields["token_symbol"])
+
+	encoded, err = json.Marshal(apiv1.TxEvent{Wallet: "0x1", TxType: apiv1.TxTypeSwap})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"wallet":"0x1","wallet_label":"","tx_hash":"","tx_type":"swap","chain":"",`+
+		`"index":0,"timestamp":0,"block":0}`, string(encoded))
+}
+
+func TestTxEvent_MarshalJSON_UnknownType(t *testing.T) {
+	var event apiv1.TxEvent
+	require.NoError(t, json.Unmarshal([]byte(unknownTxEventJSON), &event))
+
+	encoded, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.JSONEq(t, unknownTxEventJSON, string(encoded))
+}
+
+func TestUnknownEvent_MarshalJSON_InvalidRaw(t *testing.T) {
+	event := &apiv1.UnknownEvent{TxType: "restake", Raw: json.RawMessage(`[1]`)}
+
+	_, err := event.MarshalJSON()
+	require.ErrorContains(t, err, `failed to marshal unknown tx event "restake"`)
+}
+
+func TestTxDecodeOptions_KeepRaw(t *testing.T) {
+	opts := apiv1.TxDecodeOptions{KeepRaw: true}
+
+	var event apiv1.TxEvent
+	require.NoError(t, opts.UnmarshalTxEvent([]byte(swapTxEventJSON), &event))
+	assert.JSONEq(t, swapTxEventJSON, string(event.Raw))
+
+	var feed apiv1.FeedResponse
+	require.NoError(t, opts.UnmarshalFeedResponse([]byte(`{"items":[`+swapTxEventJSON+`]}`), &feed))
+	require.Len(t, feed.Items, 1)
+	assert.JSONEq(t, swapTxEventJSON, string(feed.Items[0].Raw))
+
+	var ws apiv1.WSEvent
+	require.NoError(t, opts.UnmarshalWSEvent([]byte(`{"type":"tx","data":`+swapTxEventJSON+`}`), &ws))
+	assert.JSONEq(t, swapTxEventJSON, string(ws.Data.(apiv1.TxEvent).Raw))
+
+	require.NoError(t, json.Unmarshal([]byte(swapTxEventJSON), &event))
+	assert.Nil(t, event.Raw, "json.Unmarshal uses the zero options")
+}
+
+func TestTransactionEvent_CommonAccessors(t *testing.T) {
+	txTypes := []apiv1.TxType{
+		apiv1.TxTypeBridge, apiv1.TxTypeLending, apiv1.TxTypeLP, apiv1.TxTypeNftLending, apiv1.TxTypeNftMitnt,
+		apiv1.TxTypeNftTrade, apiv1.TxTypeNftTransfer, apiv1.TxTypeSwap, apiv1.TxTypeTransfer,
+		apiv1.TxTypeContractCreation, apiv1.TxTypeContractInteraction, apiv1.TxTypeFlashloan,
+		apiv1.TxTypeNftLiquidation, apiv1.TxTypeNftSweep, apiv1.TxTypeOption, apiv1.TxTypePerp,
+		apiv1.TxTypeReward, apiv1.TxTypeStaking, apiv1.TxTypeSudoPool, apiv1.TxTypeWrap, "restake",
+	}
+
+	for _, txType := range txTypes {
+		t.Run(string(txType), func(t *testing.T) {
+			raw := `{"wallet":"0x1","wallet_label":"whale","tx_hash":"0xabc","tx_type":"` + string(txType) +
+				`","chain":"base","index":0,"timestamp":1700000000,"block":1,"from":"0x1"}`
+
+			var event apiv1.TxEvent
+			require.NoError(t, json.Unmarshal([]byte(raw), &event))
+
+			data := event.Data
+			assert.Equal(t, txType, data.GetType())
+			assert.Equal(t, "0x1", data.GetWallet())
+			assert.Equal(t, "whale", data.GetWalletLabel())
+			assert.Equal(t, "0xabc", data.GetTxHash())
+			assert.Equal(t, chains.Base, data.GetChain())
+			assert.Equal(t, time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC), data.GetTime())
+			assert.Empty(t, data.GetCounterparties(), "the wallet is not its own counterparty")
+		})
+	}
+}
+
+func TestTransactionEvent_ValuesAndAddresses(t *testing.T) {
+	tests := []struct {
+		name           string
+		event          apiv1.TransactionEvent
+		usd            float64
+		counterparties []string
+		tokens         []string
+	}{
+		{
+			"swap",
+			&apiv1.SwapEvent{Wallet: "0x1", From: "0x1", To: "0xpool", TokenAddress: "0xa", AmountUsd: 10},
+			10, []string{"0xpool"}, []string{"0xa"},
+		},
+		{
+			"lp",
+			&apiv1.LpEvent{Wallet: "0x1", From: "0x1", Token0Address: "0xa", Token1Address: "0xb", Token0AmountUSD: 5, Token1AmountUSD: 7},
+			12, nil, []string{"0xa", "0xb"},
+		},
+		{
+			"nft trade",
+			&apiv1.NftTradeEvent{Wallet: "0x1", From: "0x1", To: "0x2", Buyer: "0x1", Seller: "0x2", NftAddress: "0xnft", PriceUsd: 99},
+			99, []string{"0x2"}, []string{"0xnft"},
+		},
+		{
+			"transfer",
+			&apiv1.TransferEvent{
+				Wallet:          "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+				From:            "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+				To:              "0x3",
+				ContractAddress: "0xa",
+				AmountUsd:       1,
+			},
+			1, []string{"0x3"}, []string{"0xa"},
+		},
+		{
+			"solana transfer",
+			&apiv1.TransferEvent{
+				Wallet: "GJRhJvmjKBg9DTz1YEFkgPTF1hphCMPZQsJdBKxSLqQZ",
+				From:   "GJRhJvmjKBg9DTz1YEFkgPTF1hphCMPZQsJdBKxSLqQZ",
+				To:     "gjrhjvmjkbg9dtz1yefkgptf1hphcmpzqsjdbkxslqqz",
+			},
+			0, []string{"gjrhjvmjkbg9dtz1yefkgptf1hphcmpzqsjdbkxslqqz"}, nil,
+		},
+		{
+			"perp",
+			&apiv1.PerpEvent{From: "0x1", BaseTokenAddress: "0xa", Token0Address: "0xa", Token1Address: "0xb", AmountUsd: 3},
+			3, []string{"0x1"}, []string{"0xa", "0xb"},
+		},
+		{"option", &apiv1.OptionEvent{Amount: 2, OptionPriceUsd: 1.5}, 3, nil, nil},
+		{"contract interaction", &apiv1.ContractInteractionEvent{ContractAddress: "0xc"}, 0, []string{"0xc"}, nil},
+		{"unknown", &apiv1.UnknownEvent{TxType: "restake"}, 0, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.usd, tt.event.GetUsdValue())
+			assert.Equal(t, tt.counterparties, tt.event.GetCounterparties())
+			assert.Equal(t, tt.tokens, tt.event.GetTokenAddresses())
+		})
+	}
+
+	assert.True(t, (&apiv1.SwapEvent{}).GetTime().IsZero())
+}
@@ -0,0 +1,117 @@
+package apiv1_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const solanaWallet = "GJRhJvmjKBg9DTz1YEFkgPTF1hphCMPZQsJdBKxSLqQZ"
+
+func TestValidate(t *testing.T) {
+	tooManyWallets := make([]string, apiv1.MaxWalletsTagsWallets+1)
+	for i := range tooManyWallets {
+		tooManyWallets[i] = "0x1"
+	}
+
+	tests := []struct {
+		name   string
+		req    interface{ Validate() error }
+		fields []string
+	}{
+		{"feed valid", &apiv1.FeedRequest{Limit: apiv1.ToRef(100), MinUSD: apiv1.ToRef(1.0), MaxUSD: apiv1.ToRef(2.0)}, nil},
+		{"feed limit", &apiv1.FeedRequest{Limit: apiv1.ToRef(101)}, []string{"Limit"}},
+		{"feed zero limit", &apiv1.FeedRequest{Limit: apiv1.ToRef(0)}, []string{"Limit"}},
+		{"feed usd range", &apiv1.FeedRequest{MinUSD: apiv1.ToRef(10.0), MaxUSD: apiv1.ToRef(5.0)}, []string{"MaxUSD"}},
+		{"feed negative usd", &apiv1.FeedRequest{MinUSD: apiv1.ToRef(-1.0)}, []string{"MinUSD"}},
+		{
+			"feed timestamps",
+			&apiv1.FeedRequest{FromTimestamp: apiv1.ToRef(int64(20)), ToTimestamp: apiv1.ToRef(int64(10))},
+			[]string{"ToTimestamp"},
+		},
+		{"nfts pnl", &apiv1.NftsPnLRequest{}, []string{"Wallet"}},
+		{"tokens pnl", &apiv1.TokensPnLRequest{Wallet: " "}, []string{"Wallet"}},
+		{
+			"aggregated pnl",
+			&apiv1.AggregatedTokenPnLRequest{Timeframe: apiv1.ToRef(apiv1.AggregatedTokenPnLTimeframe("1y"))},
+			[]string{"Wallet", "Timeframe"},
+		},
+		{
+			"related wallets",
+			&apiv1.RelatedWalletsRequest{Wallet: "0x1", SortCriteria: apiv1.ToRef(apiv1.RelatedWalletsSorting("best"))},
+			[]string{"SortCriteria"},
+		},
+		{"wallet tags", &apiv1.GetWalletTagsRequest{}, []string{"Wallet"}},
+		{"wallets tags empty", &apiv1.GetWalletsTagsRequest{}, []string{"Wallets"}},
+		{"wallets tags too many", &apiv1.GetWalletsTagsRequest{Wallets: tooManyWallets}, []string{"Wallets"}},
+		{"wallets tags blank wallet", &apiv1.GetWalletsTagsRequest{Wallets: []string{"0x1", ""}}, []string{"Wallets[1]"}},
+		{
+			"wallets by tag",
+			&apiv1.GetWalletsByTagRequest{WalletType: apiv1.ToRef(apiv1.WalletType("cosmos")), Limit: apiv1.ToRef(0)},
+			[]string{"Tags", "WalletType", "Limit"},
+		},
+		{"all lists", &apiv1.GetAllWalletsListsRequest{Order: apiv1.ToRef(apiv1.WalletsListOrdering("old"))}, []string{"Order"}},
+		{"add list", &apiv1.AddWalletsListRequest{}, []string{"Name"}},
+		{"update list", &apiv1.UpdateWalletsListRequest{}, []string{"ListID", "Name"}},
+		{"tracked wallets", &apiv1.GetTrackedWalletsRequest{ListID: apiv1.ToRef(int64(-1))}, []string{"ListID"}},
+		{"add tracked wallet", &apiv1.AddTrackedWalletRequest{}, []string{"Wallet", "Label"}},
+		{"add tracked wallet valid", &apiv1.AddTrackedWalletRequest{Wallet: "0x1", Label: "whale"}, nil},
+		{"remove tracked wallets", &apiv1.RemoveTrackedWalletsRequest{}, []string{"WalletIDs"}},
+		{"update tracked wallet", &apiv1.UpdateTrackedWalletRequest{Wallet: "0x1"}, []string{"Label"}},
+		{"update tracked wallet v2", &apiv1.UpdateTrackedWalletV2Request{Label: apiv1.ToRef("")}, []string{"Label"}},
+		{"update tracked wallet v2 empty", &apiv1.UpdateTrackedWalletV2Request{}, nil},
+		{"portfolio v2 empty", &apiv1.WalletPortfolioV2Request{}, []string{"Wallets"}},
+		{"portfolio v2 token solana", &apiv1.WalletPortfolioV2Request{Wallets: []string{solanaWallet}, Token: apiv1.ToRef("mint")}, nil},
+		{
+			"portfolio v2 token evm",
+			&apiv1.WalletPortfolioV2Request{Wallets: []string{"0x8a90cab2b38dba80c64b7734e58ee1db38b8992e"}, Token: apiv1.ToRef("mint")},
+			[]string{"Token"},
+		},
+		{
+			"portfolio v2 token tron",
+			&apiv1.WalletPortfolioV2Request{Wallets: []string{"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"}, Token: apiv1.ToRef("mint")},
+			[]string{"Token"},
+		},
+		{
+			"portfolio v2 token multiple wallets",
+			&apiv1.WalletPortfolioV2Request{Wallets: []string{solanaWallet, solanaWallet}, Token: apiv1.ToRef("mint")},
+			[]string{"Token"},
+		},
+		{"token metadata", &apiv1.TokenMetadataRequest{Chain: "polygon"}, []string{"Chain", "TokenAddress"}},
+		{"token price", &apiv1.TokenPriceRequest{Chain: apiv1.TokenChainBase, TokenAddress: "0xtoken"}, nil},
+		{"token stats", &apiv1.TokenStatsRequest{TokenAddress: "0xtoken"}, []string{"Chain"}},
+		{"token balance", &apiv1.TokenBalanceRequest{Chain: apiv1.TokenChainSolana, TokenAddress: "mint"}, []string{"Wallet"}},
+		{"trading stats", &apiv1.TradingStatsRequest{Wallet: "0x1", Days: apiv1.ToRef(apiv1.TimeframeDays("2d"))}, []string{"Days"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.fields == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			var validationErr *api.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.ErrorIs(t, err, api.ErrInvalidRequest)
+
+			fields := make([]string, 0, len(validationErr.Violations))
+			for _, v := range validationErr.Violations {
+				fields = append(fields, v.Field)
+			}
+			assert.Equal(t, tt.fields, fields)
+		})
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	err := (&apiv1.UpdateWalletsListRequest{}).Validate()
+
+	assert.EqualError(t, err, "CIELO ERROR: invalid UpdateWalletsListRequest: ListID is required; Name is required")
+	assert.False(t, errors.Is(err, api.ErrNotFound))
+}
@@ -112,7 +112,13 @@ type WSEvent struct {
 	Data any       `json:"data"`
 }
 
+// UnmarshalJSON decodes the event, decoding transactions with the zero TxDecodeOptions.
 func (e *WSEvent) UnmarshalJSON(b []byte) error {
+	return TxDecodeOptions{}.UnmarshalWSEvent(b, e)
+}
+
+// UnmarshalWSEvent decodes a WebSocket event into e, decoding transactions with the options.
+func (o TxDecodeOptions) UnmarshalWSEvent(b []byte, e *WSEvent) error {
 	tmp := struct {
 		Type EventType       `json:"type"`
 		Data json.RawMessage `json:"data"`
@@ -132,7 +138,7 @@ func (e *WSEvent) UnmarshalJSON(b []byte) error {
 		}
 	case TxEventType:
 		var data TxEvent
-		if err = json.Unmarshal(tmp.Data, &data); err == nil {
+		if err = o.UnmarshalTxEvent(tmp.Data, &data); err == nil {
 			e.Data = data
 		}
 	case WalletSubscribedEventType:
@@ -1,5 +1,7 @@
+// Package chains lists the blockchains supported by the Cielo API and their metadata.
 package chains
 
+// ChainType is the chain name used by the Cielo API, e.g. "ethereum".
 type ChainType string
 
 const (
@@ -29,6 +31,37 @@ const (
 	Pulsechain   ChainType = "pulsechain"
 	Scroll       ChainType = "scroll"
 	Solana       ChainType = "solana"
+	Sui          ChainType = "sui"
 	Tron         ChainType = "tron"
 	Zksync       ChainType = "zksync"
 )
+
+// WalletType represents the blockchain type of a wallet.
+type WalletType string
+
+const (
+	// UnknownWalletType represents an unrecognized wallet type.
+	UnknownWalletType WalletType = "unknown"
+	// EvmWalletType represents EVM-compatible wallets (Ethereum, Polygon, BSC, etc.).
+	EvmWalletType WalletType = "evm"
+	// SolanaWalletType represents Solana blockchain wallets.
+	SolanaWalletType WalletType = "solana"
+	// DydxWalletType represents dYdX protocol wallets.
+	DydxWalletType WalletType = "dydx"
+	// BitcoinWalletType represents Bitcoin blockchain wallets.
+	BitcoinWalletType WalletType = "bitcoin"
+	// TronWalletType represents Tron blockchain wallets.
+	TronWalletType WalletType = "tron"
+	// SuiWalletType represents Sui blockchain wallets.
+	SuiWalletType WalletType = "sui"
+)
+
+// Valid reports whether t is a known wallet type.
+func (t WalletType) Valid() bool {
+	switch t {
+	case UnknownWalletType, EvmWalletType, SolanaWalletType, DydxWalletType, BitcoinWalletType, TronWalletType, SuiWalletType:
+		return true
+	default:
+		return false
+	}
+}
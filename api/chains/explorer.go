@@ -0,0 +1,90 @@
+package chains
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+)
+
+var (
+	explorerMu        sync.RWMutex
+	explorerOverrides = map[ChainType]Explorer{}
+)
+
+// SetExplorer replaces the block-explorer templates of a chain, e.g. to link to a self-hosted
+// Blockscout instance. It affects every ChainType link helper and the Explorer field of the
+// chains returned by the registry. It is safe for concurrent use.
+func SetExplorer(t ChainType, e Explorer) {
+	explorerMu.Lock()
+	defer explorerMu.Unlock()
+
+	explorerOverrides[t] = e
+}
+
+// ResetExplorers restores the default block-explorer templates of every chain.
+func ResetExplorers() {
+	explorerMu.Lock()
+	defer explorerMu.Unlock()
+
+	explorerOverrides = map[ChainType]Explorer{}
+}
+
+// Explorer returns the block-explorer templates of the chain, honouring SetExplorer.
+func (t ChainType) Explorer() (Explorer, bool) {
+	explorerMu.RLock()
+	e, ok := explorerOverrides[t]
+	explorerMu.RUnlock()
+	if ok {
+		return e, true
+	}
+
+	c, ok := registry[t]
+
+	return c.Explorer, ok
+}
+
+// TxURL returns the explorer page of the transaction, or "" if the chain or its template is unknown.
+func (t ChainType) TxURL(hash string) string {
+	e, _ := t.Explorer()
+
+	return e.TxLink(hash)
+}
+
+// AddressURL returns the explorer page of the wallet, or "" if the chain or its template is unknown.
+func (t ChainType) AddressURL(address string) string {
+	e, _ := t.Explorer()
+
+	return e.AddressLink(address)
+}
+
+// TokenURL returns the explorer page of the token, or "" if the chain or its template is unknown.
+func (t ChainType) TokenURL(address string) string {
+	e, _ := t.Explorer()
+
+	return e.TokenLink(address)
+}
+
+// TxLink expands the TxURL template with the transaction hash.
+func (e Explorer) TxLink(hash string) string {
+	return expand(e.TxURL, "{hash}", hash)
+}
+
+// AddressLink expands the AddressURL template with the wallet address.
+func (e Explorer) AddressLink(address string) string {
+	return expand(e.AddressURL, "{address}", address)
+}
+
+// TokenLink expands the TokenURL template with the token address.
+func (e Explorer) TokenLink(address string) string {
+	return expand(e.TokenURL, "{address}", address)
+}
+
+// expand replaces the placeholder of a template with the path-escaped value.
+// It returns "" when the template or the value is empty.
+func expand(template, placeholder, value string) string {
+	if template == "" || value == "" {
+		return ""
+	}
+
+	return strings.ReplaceAll(template, placeholder, url.PathEscape(value))
+}
@@ -0,0 +1,52 @@
+package chains_test
+
+import (
+	"testing"
+
+	"github.com/sealtv/cielogo/api/chains"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplorerURLs(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"ethereum tx", chains.Ethereum.TxURL("0xabc"), "https://etherscan.io/tx/0xabc"},
+		{"base address", chains.Base.AddressURL("0x1"), "https://basescan.org/address/0x1"},
+		{"bnb token", chains.Bnb.TokenURL("0x2"), "https://bscscan.com/token/0x2"},
+		{"solana tx", chains.Solana.TxURL("5sig"), "https://solscan.io/tx/5sig"},
+		{"solana address", chains.Solana.AddressURL("So1"), "https://solscan.io/account/So1"},
+		{"tron token", chains.Tron.TokenURL("TR7"), "https://tronscan.org/#/token20/TR7"},
+		{"bitcoin tx", chains.Bitcoin.TxURL("ff00"), "https://mempool.space/tx/ff00"},
+		{"bitcoin token", chains.Bitcoin.TokenURL("x"), ""},
+		{"unknown chain", chains.ChainType("cosmos").TxURL("0xabc"), ""},
+		{"empty hash", chains.Ethereum.TxURL(""), ""},
+		{"escaped", chains.Ethereum.AddressURL("a/b"), "https://etherscan.io/address/a%2Fb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
+
+func TestSetExplorer(t *testing.T) {
+	t.Cleanup(chains.ResetExplorers)
+
+	chains.SetExplorer(chains.Ethereum, chains.Explorer{
+		Name:       "Blockscout",
+		TxURL:      "https://eth.blockscout.com/tx/{hash}",
+		AddressURL: "https://eth.blockscout.com/address/{address}",
+	})
+
+	assert.Equal(t, "https://eth.blockscout.com/tx/0xabc", chains.Ethereum.TxURL("0xabc"))
+	assert.Equal(t, "", chains.Ethereum.TokenURL("0x2"))
+	assert.Equal(t, "Blockscout", chains.Ethereum.Info().Explorer.Name)
+	assert.Equal(t, "https://basescan.org/tx/0xabc", chains.Base.TxURL("0xabc"))
+
+	chains.ResetExplorers()
+	assert.Equal(t, "https://etherscan.io/tx/0xabc", chains.Ethereum.TxURL("0xabc"))
+}
@@ -0,0 +1,298 @@
+package chains
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrUnknownChain is returned by Parse and ChainType.Validate for chains missing from the registry.
+var ErrUnknownChain = errors.New("cielo: unknown chain")
+
+// Capability is a family of API endpoints that supports a chain.
+type Capability string
+
+const (
+	// CapabilityFeed covers the transaction feed and the WebSocket feed, whose chains filter
+	// accepts every chain of the API except Sui.
+	CapabilityFeed Capability = "feed"
+	// CapabilityPnL covers the token, NFT and aggregated PnL endpoints, whose chains filter
+	// accepts the same chains as the feed.
+	CapabilityPnL Capability = "pnl"
+	// CapabilityTracking covers tracked wallets and their notifications.
+	CapabilityTracking Capability = "tracking"
+	// CapabilityPortfolio covers the V2 wallet portfolio endpoint, the only one supporting Sui.
+	CapabilityPortfolio Capability = "portfolio"
+	// CapabilityToken covers the token metadata, price, stats and balance endpoints, which accept
+	// the chains listed by the token_chain parameter: solana, ethereum, base and hyperevm
+	// (see apiv1.TokenChains).
+	CapabilityToken Capability = "token"
+)
+
+// Explorer holds the block-explorer URL templates of a chain.
+// Templates use the {hash} and {address} placeholders; an empty template means
+// the explorer has no such page.
+type Explorer struct {
+	// Name is the display name of the explorer, e.g. "Etherscan".
+	Name string
+	// TxURL is the transaction page template, e.g. "https://etherscan.io/tx/{hash}".
+	TxURL string
+	// AddressURL is the wallet page template, e.g. "https://etherscan.io/address/{address}".
+	AddressURL string
+	// TokenURL is the token page template, e.g. "https://etherscan.io/token/{address}".
+	TokenURL string
+}
+
+// Chain is the metadata of a chain supported by the Cielo API.
+//
+// Chain has no numeric Cielo chain ID for AddTrackedWalletRequest.Chains: the API reference does
+// not publish which number stands for which chain, and the numbers are not EIP-155 chain IDs
+// (non-EVM chains such as Solana are accepted too), so the registry leaves the mapping out
+// rather than guess IDs that would track the wrong chains.
+type Chain struct {
+	// Type is the chain name used by the API.
+	Type ChainType
+	// Name is the display name, e.g. "BNB Chain".
+	Name string
+	// EVMChainID is the EIP-155 chain ID, zero for non-EVM chains.
+	EVMChainID int
+	// NativeSymbol is the symbol of the native token, e.g. "ETH".
+	NativeSymbol string
+	// WalletType is the type of the wallets on the chain.
+	WalletType WalletType
+	// Explorer holds the block-explorer URL templates, see SetExplorer.
+	Explorer Explorer
+	// Capabilities lists the endpoint families that support the chain.
+	Capabilities []Capability
+}
+
+// IsEVM reports whether the chain is EVM-compatible.
+func (c Chain) IsEVM() bool {
+	return c.WalletType == EvmWalletType
+}
+
+// Supports reports whether the endpoint family supports the chain.
+func (c Chain) Supports(capability Capability) bool {
+	return slices.Contains(c.Capabilities, capability)
+}
+
+// clone returns a copy of c that does not share the registry's slices, with the explorer
+// templates set by SetExplorer.
+func (c Chain) clone() Chain {
+	c.Capabilities = slices.Clone(c.Capabilities)
+	c.Explorer, _ = c.Type.Explorer()
+
+	return c
+}
+
+var (
+	allCapabilities = []Capability{CapabilityFeed, CapabilityPnL, CapabilityTracking, CapabilityPortfolio}
+	withToken       = []Capability{CapabilityFeed, CapabilityPnL, CapabilityTracking, CapabilityPortfolio, CapabilityToken}
+)
+
+// etherscanStyle returns the templates of an Etherscan or Blockscout explorer hosted at baseURL.
+func etherscanStyle(name, baseURL string) Explorer {
+	return Explorer{
+		Name:       name,
+		TxURL:      baseURL + "/tx/{hash}",
+		AddressURL: baseURL + "/address/{address}",
+		TokenURL:   baseURL + "/token/{address}",
+	}
+}
+
+func evm(typ ChainType, name string, id int, symbol string, explorer Explorer, capabilities []Capability) Chain {
+	return Chain{
+		Type:         typ,
+		Name:         name,
+		EVMChainID:   id,
+		NativeSymbol: symbol,
+		WalletType:   EvmWalletType,
+		Explorer:     explorer,
+		Capabilities: capabilities,
+	}
+}
+
+var registry = map[ChainType]Chain{
+	Arbitrum:     evm(Arbitrum, "Arbitrum", 42161, "ETH", etherscanStyle("Arbiscan", "https://arbiscan.io"), allCapabilities),
+	Aurora:       evm(Aurora, "Aurora", 1313161554, "ETH", etherscanStyle("Aurora Explorer", "https://explorer.aurora.dev"), allCapabilities),
+	Avalanche:    evm(Avalanche, "Avalanche", 43114, "AVAX", etherscanStyle("Snowtrace", "https://snowtrace.io"), allCapabilities),
+	Base:         evm(Base, "Base", 8453, "ETH", etherscanStyle("Basescan", "https://basescan.org"), withToken),
+	Blast:        evm(Blast, "Blast", 81457, "ETH", etherscanStyle("Blastscan", "https://blastscan.io"), allCapabilities),
+	Bnb:          evm(Bnb, "BNB Chain", 56, "BNB", etherscanStyle("BscScan", "https://bscscan.com"), allCapabilities),
+	Boba:         evm(Boba, "Boba", 288, "ETH", etherscanStyle("Bobascan", "https://bobascan.com"), allCapabilities),
+	Degenchain:   evm(Degenchain, "Degen Chain", 666666666, "DEGEN", etherscanStyle("Degen Explorer", "https://explorer.degen.tips"), allCapabilities),
+	Ethereum:     evm(Ethereum, "Ethereum", 1, "ETH", etherscanStyle("Etherscan", "https://etherscan.io"), withToken),
+	Evmos:        evm(Evmos, "Evmos", 9001, "EVMOS", etherscanStyle("Escan", "https://escan.live"), allCapabilities),
+	Fantom:       evm(Fantom, "Fantom", 250, "FTM", etherscanStyle("FTMScan", "https://ftmscan.com"), allCapabilities),
+	Gnosis:       evm(Gnosis, "Gnosis", 100, "XDAI", etherscanStyle("Gnosisscan", "https://gnosisscan.io"), allCapabilities),
+	Hyperevm:     evm(Hyperevm, "HyperEVM", 999, "HYPE", etherscanStyle("HyperEVMScan", "https://hyperevmscan.io"), withToken),
+	Linea:        evm(Linea, "Linea", 59144, "ETH", etherscanStyle("Lineascan", "https://lineascan.build"), allCapabilities),
+	Mantle:       evm(Mantle, "Mantle", 5000, "MNT", etherscanStyle("Mantlescan", "https://mantlescan.xyz"), allCapabilities),
+	Metis:        evm(Metis, "Metis", 1088, "METIS", etherscanStyle("Metis Explorer", "https://andromeda-explorer.metis.io"), allCapabilities),
+	Mode:         evm(Mode, "Mode", 34443, "ETH", etherscanStyle("Mode Explorer", "https://explorer.mode.network"), allCapabilities),
+	Optimism:     evm(Optimism, "Optimism", 10, "ETH", etherscanStyle("Optimistic Etherscan", "https://optimistic.etherscan.io"), allCapabilities),
+	Opbnb:        evm(Opbnb, "opBNB", 204, "BNB", etherscanStyle("opBNBScan", "https://opbnb.bscscan.com"), allCapabilities),
+	Polygon:      evm(Polygon, "Polygon", 137, "POL", etherscanStyle("Polygonscan", "https://polygonscan.com"), allCapabilities),
+	Polygonzkevm: evm(Polygonzkevm, "Polygon zkEVM", 1101, "ETH", etherscanStyle("zkEVM Polygonscan", "https://zkevm.polygonscan.com"), allCapabilities),
+	Pulsechain:   evm(Pulsechain, "PulseChain", 369, "PLS", etherscanStyle("PulseScan", "https://scan.pulsechain.com"), allCapabilities),
+	Scroll:       evm(Scroll, "Scroll", 534352, "ETH", etherscanStyle("Scrollscan", "https://scrollscan.com"), allCapabilities),
+	Zksync:       evm(Zksync, "zkSync Era", 324, "ETH", etherscanStyle("zkSync Era Explorer", "https://era.zksync.network"), allCapabilities),
+	Bitcoin: {
+		Type:         Bitcoin,
+		Name:         "Bitcoin",
+		NativeSymbol: "BTC",
+		WalletType:   BitcoinWalletType,
+		Explorer: Explorer{
+			Name:       "mempool.space",
+			TxURL:      "https://mempool.space/tx/{hash}",
+			AddressURL: "https://mempool.space/address/{address}",
+		},
+		Capabilities: allCapabilities,
+	},
+	Dydx: {
+		Type:         Dydx,
+		Name:         "dYdX",
+		NativeSymbol: "DYDX",
+		WalletType:   DydxWalletType,
+		Explorer: Explorer{
+			Name:       "Mintscan",
+			TxURL:      "https://www.mintscan.io/dydx/tx/{hash}",
+			AddressURL: "https://www.mintscan.io/dydx/address/{address}",
+		},
+		Capabilities: []Capability{CapabilityFeed, CapabilityPnL, CapabilityTracking},
+	},
+	Solana: {
+		Type:         Solana,
+		Name:         "Solana",
+		NativeSymbol: "SOL",
+		WalletType:   SolanaWalletType,
+		Explorer: Explorer{
+			Name:       "Solscan",
+			TxURL:      "https://solscan.io/tx/{hash}",
+			AddressURL: "https://solscan.io/account/{address}",
+			TokenURL:   "https://solscan.io/token/{address}",
+		},
+		Capabilities: withToken,
+	},
+	Sui: {
+		Type:         Sui,
+		Name:         "Sui",
+		NativeSymbol: "SUI",
+		WalletType:   SuiWalletType,
+		Explorer: Explorer{
+			Name:       "Suiscan",
+			TxURL:      "https://suiscan.xyz/mainnet/tx/{hash}",
+			AddressURL: "https://suiscan.xyz/mainnet/account/{address}",
+			TokenURL:   "https://suiscan.xyz/mainnet/coin/{address}",
+		},
+		Capabilities: []Capability{CapabilityPortfolio},
+	},
+	Tron: {
+		Type:         Tron,
+		Name:         "Tron",
+		NativeSymbol: "TRX",
+		WalletType:   TronWalletType,
+		Explorer: Explorer{
+			Name:       "Tronscan",
+			TxURL:      "https://tronscan.org/#/transaction/{hash}",
+			AddressURL: "https://tronscan.org/#/address/{address}",
+			TokenURL:   "https://tronscan.org/#/token20/{address}",
+		},
+		Capabilities: allCapabilities,
+	},
+}
+
+// aliases maps common alternative names to chain types, see Parse.
+var aliases = map[string]ChainType{
+	"eth":       Ethereum,
+	"arb":       Arbitrum,
+	"avax":      Avalanche,
+	"bsc":       Bnb,
+	"ftm":       Fantom,
+	"op":        Optimism,
+	"matic":     Polygon,
+	"sol":       Solana,
+	"btc":       Bitcoin,
+	"trx":       Tron,
+	"xdai":      Gnosis,
+	"zkevm":     Polygonzkevm,
+	"zksyncera": Zksync,
+}
+
+// Lookup returns the metadata of the chain.
+func Lookup(t ChainType) (Chain, bool) {
+	c, ok := registry[t]
+
+	return c.clone(), ok
+}
+
+// Info returns the metadata of the chain, or the zero Chain if it is not in the registry.
+func (t ChainType) Info() Chain {
+	return registry[t].clone()
+}
+
+// Valid reports whether t is a chain of the registry.
+func (t ChainType) Valid() bool {
+	_, ok := registry[t]
+
+	return ok
+}
+
+// Validate returns an error wrapping ErrUnknownChain if t is not a chain of the registry.
+func (t ChainType) Validate() error {
+	if !t.Valid() {
+		return fmt.Errorf("%w: %q", ErrUnknownChain, string(t))
+	}
+
+	return nil
+}
+
+// Parse returns the chain type named by s. It accepts chain types and display names in any case,
+// as well as common aliases such as "eth", "bsc" or "matic".
+func Parse(s string) (ChainType, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	if t := ChainType(name); t.Valid() {
+		return t, nil
+	}
+
+	if t, ok := aliases[strings.NewReplacer(" ", "", "-", "", "_", "").Replace(name)]; ok {
+		return t, nil
+	}
+
+	for _, c := range registry {
+		if strings.ToLower(c.Name) == name {
+			return c.Type, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrUnknownChain, s)
+}
+
+// All returns the metadata of every chain, sorted by chain type.
+func All() []Chain {
+	all := make([]Chain, 0, len(registry))
+	for _, c := range registry {
+		all = append(all, c.clone())
+	}
+
+	slices.SortFunc(all, func(a, b Chain) int {
+		return strings.Compare(string(a.Type), string(b.Type))
+	})
+
+	return all
+}
+
+// WithCapability returns the chains that the endpoint family supports, sorted by chain type.
+func WithCapability(capability Capability) []Chain {
+	return slices.DeleteFunc(All(), func(c Chain) bool {
+		return !c.Supports(capability)
+	})
+}
+
+// ByWalletType returns the chains whose wallets have the given type, sorted by chain type.
+func ByWalletType(walletType WalletType) []Chain {
+	return slices.DeleteFunc(All(), func(c Chain) bool {
+		return c.WalletType != walletType
+	})
+}
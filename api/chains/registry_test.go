@@ -0,0 +1,107 @@
+package chains_test
+
+import (
+	"testing"
+
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/api/chains"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	all := chains.All()
+	require.Len(t, all, 29)
+
+	for i, c := range all {
+		if i > 0 {
+			assert.Less(t, string(all[i-1].Type), string(c.Type), "All must be sorted")
+		}
+
+		assert.NotEmpty(t, c.Name, c.Type)
+		assert.NotEmpty(t, c.NativeSymbol, c.Type)
+		assert.True(t, c.WalletType.Valid(), c.Type)
+		assert.NotEmpty(t, c.Explorer.TxURL, c.Type)
+		assert.NotEmpty(t, c.Capabilities, c.Type)
+		assert.Equal(t, c.IsEVM(), c.EVMChainID != 0, c.Type)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	eth, ok := chains.Lookup(chains.Ethereum)
+	require.True(t, ok)
+	assert.Equal(t, "Ethereum", eth.Name)
+	assert.Equal(t, 1, eth.EVMChainID)
+	assert.Equal(t, "ETH", eth.NativeSymbol)
+	assert.Equal(t, chains.EvmWalletType, eth.WalletType)
+	assert.True(t, eth.Supports(chains.CapabilityToken))
+
+	eth.Capabilities[0] = "mutated"
+	assert.Equal(t, chains.CapabilityFeed, chains.Ethereum.Info().Capabilities[0], "returned chains must not alias the registry")
+
+	sui := chains.Sui.Info()
+	assert.Equal(t, chains.SuiWalletType, sui.WalletType)
+	assert.False(t, sui.IsEVM())
+	assert.Equal(t, []chains.Capability{chains.CapabilityPortfolio}, sui.Capabilities)
+
+	_, ok = chains.Lookup("cosmos")
+	assert.False(t, ok)
+	assert.Equal(t, chains.Chain{}, chains.ChainType("cosmos").Info())
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want chains.ChainType
+	}{
+		{"ethereum", chains.Ethereum},
+		{" Ethereum ", chains.Ethereum},
+		{"eth", chains.Ethereum},
+		{"BSC", chains.Bnb},
+		{"BNB Chain", chains.Bnb},
+		{"matic", chains.Polygon},
+		{"Polygon zkEVM", chains.Polygonzkevm},
+		{"zksync-era", chains.Zksync},
+		{"sui", chains.Sui},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := chains.Parse(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := chains.Parse("cosmos")
+	assert.ErrorIs(t, err, chains.ErrUnknownChain)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, chains.Solana.Validate())
+	assert.ErrorIs(t, chains.ChainType("Solana").Validate(), chains.ErrUnknownChain)
+	assert.True(t, chains.Tron.Valid())
+	assert.False(t, chains.ChainType("").Valid())
+}
+
+func TestWithCapability(t *testing.T) {
+	var tokenChains []apiv1.TokenChain
+	for _, c := range chains.WithCapability(chains.CapabilityToken) {
+		tokenChains = append(tokenChains, apiv1.TokenChain(c.Type))
+	}
+	assert.ElementsMatch(t, apiv1.TokenChains(), tokenChains)
+
+	portfolio := chains.WithCapability(chains.CapabilityPortfolio)
+	assert.Contains(t, portfolio, chains.Sui.Info())
+	assert.NotContains(t, portfolio, chains.Dydx.Info())
+
+	assert.NotContains(t, chains.WithCapability(chains.CapabilityFeed), chains.Sui.Info())
+}
+
+func TestByWalletType(t *testing.T) {
+	solana := chains.ByWalletType(chains.SolanaWalletType)
+	require.Len(t, solana, 1)
+	assert.Equal(t, chains.Solana, solana[0].Type)
+
+	assert.Len(t, chains.ByWalletType(chains.EvmWalletType), 24)
+}
@@ -1,12 +1,76 @@
 package api
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
 
+// Sentinel errors that *Error matches with errors.Is, based on the HTTP status and message.
+var (
+	ErrNotFound            = errors.New("cielo: not found")
+	ErrUnauthorized        = errors.New("cielo: unauthorized")
+	ErrRateLimited         = errors.New("cielo: rate limited")
+	ErrInsufficientCredits = errors.New("cielo: insufficient credits")
+	ErrDataNotReady        = errors.New("cielo: data not ready")
+)
+
+// MaxErrorBodySize is the maximum number of raw response body bytes kept in Error.Body.
+const MaxErrorBodySize = 4 << 10
+
+// Error is returned for any non-successful API response.
+// Message and Status come from the JSON error envelope, when the body has one.
 type Error struct {
 	Message string `json:"message"`
 	Status  string `json:"status"`
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"-"`
+	// Method is the HTTP method of the failed request.
+	Method string `json:"-"`
+	// Path is the request path, including the query string.
+	Path string `json:"-"`
+	// Header contains the response headers.
+	Header http.Header `json:"-"`
+	// Body is the raw response body, truncated to MaxErrorBodySize bytes.
+	Body []byte `json:"-"`
 }
 
 func (e Error) Error() string {
-	return fmt.Sprintf("CIELO ERROR: %s", e.Message)
+	msg := e.Message
+	if msg == "" {
+		msg = strings.TrimSpace(string(e.Body))
+	}
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+
+	if e.StatusCode == 0 {
+		return fmt.Sprintf("CIELO ERROR: %s", msg)
+	}
+
+	return fmt.Sprintf("CIELO ERROR: %s (%s %s: status %d)", msg, e.Method, e.Path, e.StatusCode)
+}
+
+// Is reports whether the error matches one of the package sentinel errors.
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return (e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden) && !e.mentionsCredits()
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests && !e.mentionsCredits()
+	case ErrInsufficientCredits:
+		return e.StatusCode == http.StatusPaymentRequired || (e.StatusCode >= 400 && e.mentionsCredits())
+	case ErrDataNotReady:
+		return e.StatusCode == http.StatusAccepted
+	default:
+		return false
+	}
+}
+
+func (e Error) mentionsCredits() bool {
+	return strings.Contains(strings.ToLower(e.Message), "credit")
 }
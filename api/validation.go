@@ -0,0 +1,74 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidRequest is matched by *ValidationError with errors.Is.
+var ErrInvalidRequest = errors.New("cielo: invalid request")
+
+// FieldViolation is a constraint violated by a request field.
+type FieldViolation struct {
+	// Field is the name of the request struct field, e.g. "Limit" or "Wallets[2]".
+	Field string
+	// Message describes the violated constraint.
+	Message string
+}
+
+func (v FieldViolation) String() string {
+	return v.Field + " " + v.Message
+}
+
+// ValidationError is returned when a request is rejected before it is sent to the API.
+type ValidationError struct {
+	// Request is the name of the request type, e.g. "FeedRequest".
+	Request string
+	// Violations lists every invalid field.
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	violations := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		violations = append(violations, v.String())
+	}
+
+	return fmt.Sprintf("CIELO ERROR: invalid %s: %s", e.Request, strings.Join(violations, "; "))
+}
+
+// Is reports whether target is ErrInvalidRequest.
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidRequest
+}
+
+// Validator collects the field violations of a request.
+//
+//	v := api.Validator{}
+//	v.Check(r.Limit == nil || *r.Limit <= 100, "Limit", "must be at most 100")
+//	return v.Err("FeedRequest")
+type Validator struct {
+	violations []FieldViolation
+}
+
+// Check records a violation of field when ok is false.
+func (v *Validator) Check(ok bool, field, format string, args ...any) {
+	if !ok {
+		v.violations = append(v.violations, FieldViolation{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+}
+
+// Required records a violation when value is empty.
+func (v *Validator) Required(value, field string) {
+	v.Check(strings.TrimSpace(value) != "", field, "is required")
+}
+
+// Err returns a *ValidationError listing the recorded violations, or nil if there are none.
+func (v *Validator) Err(request string) error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Request: request, Violations: v.violations}
+}
@@ -0,0 +1,157 @@
+package cielogo
+
+import (
+	"container/list"
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Cache stores raw API response bodies for GET requests.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for key, if present and not expired.
+	Get(key string) ([]byte, bool)
+	// Set stores value for key for the given time to live.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// WithCache enables response caching for GET requests.
+// Responses are cached for defaultTTL unless an endpoint specific TTL is set with WithCacheTTL;
+// a defaultTTL of zero only caches endpoints with an explicit TTL.
+// Cache keys are built from the method, path and query string, so a cache should not be
+// shared between clients that use different API keys.
+// Cache hits are not sent to the API and do not count against credit accounting.
+func WithCache(cache Cache, defaultTTL time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cache.store = cache
+		c.cache.defaultTTL = defaultTTL
+	}
+}
+
+// WithCacheTTL sets the cache TTL for a single endpoint, see the Endpoint constants.
+// A TTL of zero disables caching for the endpoint.
+func WithCacheTTL(endpoint string, ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		if c.cache.ttls == nil {
+			c.cache.ttls = make(map[string]time.Duration)
+		}
+
+		c.cache.ttls[endpoint] = ttl
+	}
+}
+
+type bypassCacheKey struct{}
+
+// BypassCache returns a context that makes requests skip the response cache:
+// they are always sent to the API and their responses are not stored.
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+// responseCache holds the cache configuration of a client.
+type responseCache struct {
+	store      Cache
+	defaultTTL time.Duration
+	ttls       map[string]time.Duration
+}
+
+// policy returns the cache key and TTL for a call, and whether the call may use the cache.
+func (rc *responseCache) policy(ctx context.Context, call *Call) (string, time.Duration, bool) {
+	if rc.store == nil || call.Method != http.MethodGet {
+		return "", 0, false
+	}
+
+	if bypass, _ := ctx.Value(bypassCacheKey{}).(bool); bypass {
+		return "", 0, false
+	}
+
+	ttl, ok := rc.ttls[call.Endpoint]
+	if !ok {
+		ttl = rc.defaultTTL
+	}
+
+	if ttl <= 0 {
+		return "", 0, false
+	}
+
+	return call.Method + " " + call.Path, ttl, true
+}
+
+// LRUCache is an in-memory Cache that evicts the least recently used entries
+// once it holds more than its capacity.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewLRUCache creates an in-memory cache holding at most capacity entries.
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		capacity: max(capacity, 1),
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the value stored for key, if present and not expired.
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*lruEntry) //nolint:errcheck // the list only holds *lruEntry values
+	if time.Now().After(entry.expiresAt) {
+		c.remove(elem)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+
+	return entry.value, true
+}
+
+// Set stores value for key for the given time to live.
+func (c *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &lruEntry{key: key, value: value, expiresAt: time.Now().Add(ttl)}
+	if elem, ok := c.items[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+// Len returns the number of entries in the cache, including expired ones not evicted yet.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// remove deletes an element. It must be called with c.mu held.
+func (c *LRUCache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.items, elem.Value.(*lruEntry).key) //nolint:errcheck // the list only holds *lruEntry values
+}
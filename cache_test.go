@@ -0,0 +1,186 @@
+package cielogo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const metadataQueryPath = "/v1/token/metadata?chain=solana&token_address=token"
+
+var metadataRequest = &apiv1.TokenMetadataRequest{Chain: apiv1.TokenChainSolana, TokenAddress: "token"}
+
+// newCachedServer returns a server that answers the token metadata and price requests.
+func newCachedServer(t *testing.T) *testutil.MockServer {
+	t.Helper()
+
+	server := testutil.NewMockServer(t)
+	server.SetResponse(metadataQueryPath, api.CieloResponse[apiv1.TokenMetadataResponse]{
+		Data: apiv1.TokenMetadataResponse{Symbol: "USDC", Decimals: 6},
+	})
+	server.SetResponse(priceQueryPath, api.CieloResponse[apiv1.TokenPriceResponse]{
+		Data: apiv1.TokenPriceResponse{Price: 1.5},
+	})
+
+	return server
+}
+
+func TestCache_HitSkipsRequestAndCredits(t *testing.T) {
+	server := newCachedServer(t)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithCache(cielogo.NewLRUCache(10), time.Minute),
+	)
+	ctx := context.Background()
+
+	for range 3 {
+		resp, err := client.GetTokenMetadataV1(ctx, metadataRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "USDC", resp.Symbol)
+		assert.Equal(t, 6, resp.Decimals)
+	}
+
+	server.AssertRequestCount(t, metadataQueryPath, 1)
+	assert.Equal(t, 1, client.CreditUsage().Total)
+}
+
+func TestCache_PerEndpointTTL(t *testing.T) {
+	server := newCachedServer(t)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithCache(cielogo.NewLRUCache(10), 0),
+		cielogo.WithCacheTTL(cielogo.EndpointGetTokenMetadataV1, time.Hour),
+	)
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := client.GetTokenMetadataV1(ctx, metadataRequest)
+		require.NoError(t, err)
+		_, err = client.GetTokenPriceV1(ctx, priceRequest)
+		require.NoError(t, err)
+	}
+
+	server.AssertRequestCount(t, metadataQueryPath, 1)
+	server.AssertRequestCount(t, priceQueryPath, 2)
+}
+
+func TestCache_Expiry(t *testing.T) {
+	server := newCachedServer(t)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithCache(cielogo.NewLRUCache(10), 20*time.Millisecond),
+	)
+	ctx := context.Background()
+
+	_, err := client.GetTokenPriceV1(ctx, priceRequest)
+	require.NoError(t, err)
+	time.Sleep(30 * time.Millisecond)
+	_, err = client.GetTokenPriceV1(ctx, priceRequest)
+	require.NoError(t, err)
+
+	server.AssertRequestCount(t, priceQueryPath, 2)
+}
+
+func TestCache_Bypass(t *testing.T) {
+	server := newCachedServer(t)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithCache(cielogo.NewLRUCache(10), time.Minute),
+	)
+	ctx := context.Background()
+
+	_, err := client.GetTokenPriceV1(ctx, priceRequest)
+	require.NoError(t, err)
+	_, err = client.GetTokenPriceV1(cielogo.BypassCache(ctx), priceRequest)
+	require.NoError(t, err)
+	_, err = client.GetTokenPriceV1(ctx, priceRequest)
+	require.NoError(t, err)
+
+	server.AssertRequestCount(t, priceQueryPath, 2)
+}
+
+func TestCache_ErrorsNotCached(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithCache(cielogo.NewLRUCache(10), time.Minute),
+	)
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := client.GetTokenPriceV1(ctx, priceRequest)
+		require.Error(t, err)
+	}
+
+	server.AssertRequestCount(t, priceQueryPath, 2)
+}
+
+func TestLRUCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := cielogo.NewLRUCache(2)
+	cache.Set("a", []byte("1"), time.Minute)
+	cache.Set("b", []byte("2"), time.Minute)
+
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+
+	cache.Set("c", []byte("3"), time.Minute)
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok, "b is the least recently used entry")
+	_, ok = cache.Get("a")
+	assert.True(t, ok)
+	_, ok = cache.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestFileCache(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := cielogo.NewFileCache(dir)
+	require.NoError(t, err)
+
+	cache.Set("GET /v1/token/price", []byte(`{"status":"ok"}`), time.Minute)
+	cache.Set("GET /v1/expired", []byte(`{}`), -time.Second)
+
+	value, ok := cache.Get("GET /v1/token/price")
+	require.True(t, ok)
+	assert.JSONEq(t, `{"status":"ok"}`, string(value))
+
+	_, ok = cache.Get("GET /v1/expired")
+	assert.False(t, ok)
+	_, ok = cache.Get("GET /v1/missing")
+	assert.False(t, ok)
+
+	// A second cache on the same directory sees the stored entries.
+	reopened, err := cielogo.NewFileCache(dir)
+	require.NoError(t, err)
+	_, ok = reopened.Get("GET /v1/token/price")
+	assert.True(t, ok)
+}
+
+func TestFileCache_WithClient(t *testing.T) {
+	server := newCachedServer(t)
+	cache, err := cielogo.NewFileCache(t.TempDir())
+	require.NoError(t, err)
+
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithCache(cache, time.Minute),
+	)
+	ctx := context.Background()
+
+	for range 2 {
+		resp, err := client.GetTokenPriceV1(ctx, priceRequest)
+		require.NoError(t, err)
+		assert.InDelta(t, 1.5, resp.Price, 0.0001)
+	}
+
+	server.AssertRequestCount(t, priceQueryPath, 1)
+}
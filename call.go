@@ -0,0 +1,91 @@
+package cielogo
+
+import (
+	"context"
+	"net/http"
+)
+
+// Endpoint names identify the typed client methods in Call descriptors,
+// credit usage reports and per-endpoint options.
+const (
+	EndpointGetFeedV1                 = "GetFeedV1"
+	EndpointGetNftsPnlV1              = "GetNftsPnlV1"
+	EndpointGetTokensPnlV1            = "GetTokensPnlV1"
+	EndpointGetAggregatedTokenPnLV1   = "GetAggregatedTokenPnLV1"
+	EndpointGetRelatedWalletsV1       = "GetRelatedWalletsV1"
+	EndpointGetWalletTagsV1           = "GetWalletTagsV1"
+	EndpointGetWalletsTagsV1          = "GetWalletsTagsV1"
+	EndpointGetWalletsByTagV1         = "GetWalletsByTagV1"
+	EndpointGetAllWalletsListV1       = "GetAllWalletsListV1"
+	EndpointGetUserWalletsListsV1     = "GetUserWalletsListsV1"
+	EndpointAddWalletsListV1          = "AddWalletsListV1"
+	EndpointUpdateWalletsListV1       = "UpdateWalletsListV1"
+	EndpointDeleteWalletsListV1       = "DeleteWalletsListV1"
+	EndpointToggleFollowWalletsListV1 = "ToggleFollowWalletsListV1"
+	EndpointGetTrackedWalletsV1       = "GetTrackedWalletsV1"
+	EndpointAddTrackedWalletsV1       = "AddTrackedWalletsV1"
+	EndpointRemoveTrackedWalletsV1    = "RemoveTrackedWalletsV1"
+	EndpointGetWalletByAddressV1      = "GetWalletByAddressV1"
+	EndpointUpdateTrackedWalletV1     = "UpdateTrackedWalletV1"
+	EndpointUpdateTrackedWalletV2     = "UpdateTrackedWalletV2"
+	EndpointGetTelegramBotsV1         = "GetTelegramBotsV1"
+	EndpointGetWalletPortfolioV1      = "GetWalletPortfolioV1"
+	EndpointGetWalletPortfolioV2      = "GetWalletPortfolioV2"
+	EndpointGetTokenMetadataV1        = "GetTokenMetadataV1"
+	EndpointGetTokenPriceV1           = "GetTokenPriceV1"
+	EndpointGetTokenStatsV1           = "GetTokenStatsV1"
+	EndpointGetTokenBalanceV1         = "GetTokenBalanceV1"
+	EndpointGetTradingStatsV1         = "GetTradingStatsV1"
+)
+
+// Call describes a single typed API call made by the client.
+type Call struct {
+	// Endpoint is the name of the client method, see the Endpoint constants.
+	Endpoint string
+	// Method is the HTTP method.
+	Method string
+	// Path is the request path relative to the base URL, including the query string.
+	Path string
+	// Header holds additional HTTP headers sent with the request.
+	Header http.Header
+	// Request is the typed request object passed to the client method, or nil for methods without one.
+	Request any
+	// Credits is the estimated credit cost of a single request, as documented by the API.
+	Credits int
+	// Result points to the value the response is decoded into, e.g. *api.CieloResponse[apiv1.FeedResponse].
+	// It is nil for calls without a response body.
+	Result any
+
+	// body is the JSON request body, if any.
+	body any
+	// args validates the arguments of methods that take them as bare values instead of a request object.
+	args validator
+}
+
+// Invoker performs an API call and decodes the response into call.Result.
+type Invoker func(ctx context.Context, call *Call) error
+
+// Interceptor wraps every API call made by the client. It can observe or modify the call
+// before passing it to next, inspect call.Result or the error once next returns, or
+// short-circuit the call by returning without calling next (filling call.Result itself).
+type Interceptor func(ctx context.Context, call *Call, next Invoker) error
+
+// WithInterceptors adds interceptors around every API call.
+// The first interceptor is the outermost one: it sees the call first and the result last.
+func WithInterceptors(interceptors ...Interceptor) ClientOption {
+	return func(c *Client) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+// chainInterceptors wraps invoker with interceptors, the first one being the outermost.
+func chainInterceptors(interceptors []Interceptor, invoker Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, call *Call) error {
+			return interceptor(ctx, call, next)
+		}
+	}
+
+	return invoker
+}
@@ -0,0 +1,121 @@
+package cielogo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterceptors_ObserveCallAndResult(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	server.SetResponse(priceQueryPath, api.CieloResponse[apiv1.TokenPriceResponse]{
+		Data: apiv1.TokenPriceResponse{Price: 1.5},
+	})
+
+	var order []string
+	var seen cielogo.Call
+	var price float64
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithInterceptors(
+			func(ctx context.Context, call *cielogo.Call, next cielogo.Invoker) error {
+				order = append(order, "outer:before")
+				err := next(ctx, call)
+				order = append(order, "outer:after")
+				return err
+			},
+			func(ctx context.Context, call *cielogo.Call, next cielogo.Invoker) error {
+				order = append(order, "inner:before")
+				seen = *call
+				err := next(ctx, call)
+				if resp, ok := call.Result.(*api.CieloResponse[apiv1.TokenPriceResponse]); ok {
+					price = resp.Data.Price
+				}
+				order = append(order, "inner:after")
+				return err
+			},
+		),
+	)
+
+	_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"outer:before", "inner:before", "inner:after", "outer:after"}, order)
+	assert.Equal(t, cielogo.EndpointGetTokenPriceV1, seen.Endpoint)
+	assert.Equal(t, http.MethodGet, seen.Method)
+	assert.Equal(t, "/v1/token/price?chain=solana&token_address=token", seen.Path)
+	assert.Equal(t, 1, seen.Credits)
+	assert.Same(t, priceRequest, seen.Request)
+	assert.InDelta(t, 1.5, price, 0.0001)
+}
+
+func TestInterceptors_ShortCircuit(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithInterceptors(func(ctx context.Context, call *cielogo.Call, next cielogo.Invoker) error {
+			resp, ok := call.Result.(*api.CieloResponse[apiv1.TokenPriceResponse])
+			require.True(t, ok)
+			resp.Data.Price = 42
+			return nil
+		}),
+	)
+
+	resp, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.NoError(t, err)
+	assert.InDelta(t, 42.0, resp.Price, 0.0001)
+	server.AssertRequestCount(t, priceQueryPath, 0)
+	assert.Equal(t, 0, client.CreditUsage().Calls)
+}
+
+func TestInterceptors_InjectHeaderAndSeeError(t *testing.T) {
+	var gotHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("X-Trace-Id")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"status":"error","message":"not tracked"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	var interceptedErr error
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithInterceptors(func(ctx context.Context, call *cielogo.Call, next cielogo.Invoker) error {
+			if call.Header == nil {
+				call.Header = http.Header{}
+			}
+			call.Header.Set("X-Trace-Id", "trace-1")
+			interceptedErr = next(ctx, call)
+			return interceptedErr
+		}),
+	)
+
+	_, err := client.GetWalletByAddressV1(context.Background(), "0x123")
+	require.Error(t, err)
+	assert.Equal(t, "trace-1", gotHeader)
+	assert.ErrorIs(t, interceptedErr, api.ErrNotFound)
+}
+
+func TestInterceptors_CanReplaceError(t *testing.T) {
+	errBlocked := errors.New("blocked by policy")
+	client := cielogo.NewClient("test-key",
+		cielogo.WithInterceptors(func(ctx context.Context, call *cielogo.Call, next cielogo.Invoker) error {
+			if call.Method != http.MethodGet {
+				return errBlocked
+			}
+			return next(ctx, call)
+		}),
+	)
+
+	err := client.DeleteWalletsListV1(context.Background(), 1, true)
+	assert.ErrorIs(t, err, errBlocked)
+}
@@ -7,16 +7,37 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
 )
 
 const apiBaseUrl = "https://feed-api.cielo.finance/api"
 
 type Client struct {
-	apiKey  string
-	baseURL string
-	cli     *http.Client
+	apiKey   string
+	baseURL  string
+	cli      *http.Client
+	retry    RetryPolicy
+	credits  *creditMeter
+	limiter  *rateLimiter
+	cache    responseCache
+	inflight *inflightGroup
+
+	interceptors []Interceptor
+	invoker      Invoker
+
+	logger       clientLogger
+	metrics      *Metrics
+	tracer       Tracer
+	websocketURL string
+
+	skipValidation bool
+	txDecoding     apiv1.TxDecodeOptions
+
+	statsPollInterval time.Duration
+	statsMaxWait      time.Duration
 }
 
 // ClientOption is a function that configures a Client
@@ -50,31 +71,145 @@ func NewClient(apiKey string, opts ...ClientOption) *Client {
 		cli: &http.Client{
 			Transport: transport,
 		},
+		credits:           newCreditMeter(),
+		limiter:           newRateLimiter(),
+		logger:            clientLogger{levels: DefaultLogLevels(), apiKey: apiKey},
+		websocketURL:      wsURL,
+		metrics:           newMetrics(),
+		tracer:            noopTracer{},
+		statsPollInterval: defaultTradingStatsPollInterval,
+		statsMaxWait:      defaultTradingStatsMaxWait,
 	}
 
 	for _, opt := range opts {
 		opt(client)
 	}
 
+	client.invoker = chainInterceptors(client.interceptors, client.invoke)
+
 	return client
 }
 
-func (c *Client) makeRequest(ctx context.Context, method, path string, bodyObj, out any) error {
-	url := c.baseURL + path
+// makeRequest validates the request, traces the call, runs it through the interceptor chain
+// and decodes the response into out.
+func (c *Client) makeRequest(ctx context.Context, call *Call, out any) error {
+	if err := c.validate(call); err != nil {
+		return err
+	}
 
-	var body io.Reader
-	if bodyObj != nil {
+	call.Result = out
+
+	return c.traceCall(ctx, call, c.invoker)
+}
+
+// invoke performs the call: it consults the cache, sends the request and decodes the response.
+func (c *Client) invoke(ctx context.Context, call *Call) error {
+	start := time.Now()
+
+	key, ttl, cacheable := c.cache.policy(ctx, call)
+	if cacheable {
+		if body, ok := c.cache.store.Get(key); ok {
+			c.logger.logCacheHit(ctx, call)
+			c.metrics.observeCacheHit(call)
+			fillResponseMeta(ctx, start, &response{body: body}, true, nil)
+
+			return c.decodeResult(body, call.Result)
+		}
+	}
+
+	resp, err := c.fetch(ctx, call)
+	fillResponseMeta(ctx, start, resp, false, err)
+	if err != nil {
+		return err
+	}
+
+	if cacheable {
+		c.cache.store.Set(key, resp.body, ttl)
+	}
+
+	return c.decodeResult(resp.body, call.Result)
+}
+
+// send performs the request, retrying it according to the retry policy.
+// The returned response holds the body of the successful response; it is also
+// returned alongside the error of a failed call once at least one attempt was made.
+func (c *Client) send(ctx context.Context, call *Call) (*response, error) {
+	var payload []byte
+	if call.body != nil {
 		buf := new(bytes.Buffer)
-		if err := json.NewEncoder(buf).Encode(bodyObj); err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
+		if err := json.NewEncoder(buf).Encode(call.body); err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+
+		payload = buf.Bytes()
+	}
+
+	result := &response{}
+	retryable := c.retry.allows(call.Method)
+	for attempt := 1; ; attempt++ {
+		// The budget is checked before taking a rate limit token, and the token is given back
+		// if a concurrent call used up the budget in the meantime.
+		if err := c.credits.check(call); err != nil {
+			return result, err
+		}
+
+		if err := c.limiter.wait(ctx, call.Endpoint); err != nil {
+			return result, err
+		}
+
+		if err := c.credits.spend(call); err != nil {
+			c.limiter.release(call.Endpoint)
+			return result, err
+		}
+
+		start := time.Now()
+		resp, body, err := c.doRequest(ctx, call, payload)
+		latency := time.Since(start)
+
+		result.attempts = attempt
+		if resp != nil {
+			result.statusCode, result.header = resp.StatusCode, resp.Header
 		}
+		c.metrics.observeAttempt(call, result.statusCode, latency, len(body), err)
 
-		body = buf
+		if err == nil || !retryable {
+			c.logger.logAttempt(ctx, call, attempt, result.statusCode, latency, err, false)
+			result.body = body
+
+			return result, err
+		}
+
+		delay, ok := c.retry.shouldRetry(ctx, attempt, resp, err)
+		c.logger.logAttempt(ctx, call, attempt, result.statusCode, latency, err, ok)
+		if !ok {
+			return result, err
+		}
+
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			return result, err
+		}
 	}
+}
 
-	req, err := http.NewRequestWithContext(ctx, method, url, body)
+// doRequest performs a single attempt of the request and returns the body of a successful response.
+// The returned response, if any, has its body already consumed and closed.
+func (c *Client) doRequest(ctx context.Context, call *Call, payload []byte) (*http.Response, []byte, error) {
+	url := c.baseURL + call.Path
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, call.Method, url, body)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for name, values := range call.Header {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
 	}
 
 	req.Header.Add("X-Api-Key", c.apiKey)
@@ -83,24 +218,60 @@ func (c *Client) makeRequest(ctx context.Context, method, path string, bodyObj,
 
 	resp, err := c.cli.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to do request: %w", err)
+		return nil, nil, fmt.Errorf("failed to do request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		var cErr error = &api.Error{}
-		if err := json.NewDecoder(resp.Body).Decode(cErr); err != nil {
-			return fmt.Errorf("failed to unmarshal error response body: %w, status code: %d", err, resp.StatusCode)
-		}
+		return resp, nil, newAPIError(req, resp)
+	}
 
-		return cErr
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	if resp != nil && out != nil {
-		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
-			return fmt.Errorf("failed to unmarshal response body: %w", err)
-		}
+	return resp, respBody, nil
+}
+
+// decodeResponse decodes a successful response body into out, if set.
+func decodeResponse(body []byte, out any) error {
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to unmarshal response body: %w", err)
 	}
 
 	return nil
 }
+
+// maxErrorResponseSize limits how much of an error response body is read.
+const maxErrorResponseSize = 64 << 10
+
+// newAPIError builds an *api.Error from a non-successful response.
+// The JSON error envelope is decoded when present; the raw body is kept either way.
+func newAPIError(req *http.Request, resp *http.Response) error {
+	cErr := &api.Error{
+		StatusCode: resp.StatusCode,
+		Method:     req.Method,
+		Path:       req.URL.RequestURI(),
+		Header:     resp.Header,
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorResponseSize))
+	if err != nil {
+		return fmt.Errorf("failed to read error response body: %w, status code: %d", err, resp.StatusCode)
+	}
+
+	// A body that is not a JSON envelope is still exposed through cErr.Body.
+	_ = json.Unmarshal(raw, cErr)
+
+	if len(raw) > api.MaxErrorBodySize {
+		raw = raw[:api.MaxErrorBodySize]
+	}
+	cErr.Body = raw
+
+	return cErr
+}
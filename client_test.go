@@ -1,11 +1,15 @@
 package cielogo_test
 
 import (
+	"context"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -57,3 +61,85 @@ func TestClient_EmptyAPIKey(t *testing.T) {
 	client := cielogo.NewClient("")
 	assert.NotNil(t, client)
 }
+
+const walletByAddressPath = "/v1/tracked-wallets/address/0x123"
+
+// newStatusServer returns a server that answers GetWalletByAddressV1 with the given status and raw body.
+func newStatusServer(t *testing.T, status int, body string) *testutil.MockServer {
+	t.Helper()
+
+	server := testutil.NewMockServer(t)
+	server.QueueReplies(walletByAddressPath, testutil.MockReply{
+		Status: status,
+		Header: http.Header{"X-Request-Id": {"req-1"}},
+		Body:   body,
+	})
+
+	return server
+}
+
+func TestAPIError_Sentinels(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		sentinel error
+	}{
+		{"not found", http.StatusNotFound, `{"status":"error","message":"wallet not tracked"}`, api.ErrNotFound},
+		{"unauthorized", http.StatusUnauthorized, `{"status":"error","message":"invalid api key"}`, api.ErrUnauthorized},
+		{"rate limited", http.StatusTooManyRequests, `{"status":"error","message":"slow down"}`, api.ErrRateLimited},
+		{"payment required", http.StatusPaymentRequired, `{"status":"error","message":"no plan"}`, api.ErrInsufficientCredits},
+		{"credits in message", http.StatusForbidden, `{"status":"error","message":"Not enough credits"}`, api.ErrInsufficientCredits},
+		{"data not ready", http.StatusAccepted, `{"status":"pending","message":"computing"}`, api.ErrDataNotReady},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newStatusServer(t, tt.status, tt.body)
+			client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL))
+
+			_, err := client.GetWalletByAddressV1(context.Background(), "0x123")
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.sentinel)
+
+			var apiErr *api.Error
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, http.MethodGet, apiErr.Method)
+			assert.Equal(t, walletByAddressPath, apiErr.Path)
+			assert.Equal(t, "req-1", apiErr.Header.Get("X-Request-Id"))
+		})
+	}
+}
+
+func TestAPIError_CreditsNotUnauthorized(t *testing.T) {
+	server := newStatusServer(t, http.StatusForbidden, `{"status":"error","message":"Not enough credits"}`)
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL))
+
+	_, err := client.GetWalletByAddressV1(context.Background(), "0x123")
+	assert.NotErrorIs(t, err, api.ErrUnauthorized)
+}
+
+func TestAPIError_NonJSONBody(t *testing.T) {
+	server := newStatusServer(t, http.StatusBadGateway, "<html>bad gateway</html>")
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL))
+
+	_, err := client.GetWalletByAddressV1(context.Background(), "0x123")
+
+	var apiErr *api.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	assert.Equal(t, "<html>bad gateway</html>", string(apiErr.Body))
+	assert.Contains(t, err.Error(), "bad gateway")
+}
+
+func TestAPIError_BodyTruncated(t *testing.T) {
+	server := newStatusServer(t, http.StatusInternalServerError, strings.Repeat("x", api.MaxErrorBodySize*2))
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL))
+
+	_, err := client.GetWalletByAddressV1(context.Background(), "0x123")
+
+	var apiErr *api.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Len(t, apiErr.Body, api.MaxErrorBodySize)
+}
@@ -0,0 +1,130 @@
+package cielogo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// WithRequestCoalescing deduplicates identical concurrent GET requests (same path, query and
+// headers): only one HTTP request is sent and every caller receives its result.
+// The shared request is bounded by the deadline of the caller that started it. A caller whose
+// context is done stops waiting without aborting the shared request; the request is only
+// canceled once no caller is waiting for it anymore.
+func WithRequestCoalescing() ClientOption {
+	return func(c *Client) {
+		c.inflight = &inflightGroup{calls: make(map[string]*inflightCall)}
+	}
+}
+
+// fetch sends the call, sharing the request with identical in-flight GET calls when coalescing is enabled.
+func (c *Client) fetch(ctx context.Context, call *Call) (*response, error) {
+	if c.inflight == nil || call.Method != http.MethodGet {
+		return c.send(ctx, call)
+	}
+
+	return c.inflight.do(ctx, inflightKey(call), func(ctx context.Context) (*response, error) {
+		return c.send(ctx, call)
+	})
+}
+
+// inflightKey identifies identical calls by method, path and headers.
+func inflightKey(call *Call) string {
+	key := call.Method + " " + call.Path
+	if len(call.Header) == 0 {
+		return key
+	}
+
+	var b strings.Builder
+	_ = call.Header.Write(&b) // writing to a strings.Builder never fails
+
+	return key + "\n" + b.String()
+}
+
+// inflightGroup tracks in-flight requests by key.
+type inflightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inflightCall
+}
+
+type inflightCall struct {
+	done    chan struct{}
+	resp    *response
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// do runs fn once per key at a time and returns its result to every concurrent caller.
+// fn receives a context that keeps the deadline of the first caller but not its cancellation,
+// and is canceled when all the callers have left.
+func (g *inflightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (*response, error)) (*response, error) {
+	g.mu.Lock()
+	if ic, ok := g.calls[key]; ok {
+		ic.waiters++
+		g.mu.Unlock()
+
+		return g.wait(ctx, key, ic)
+	}
+
+	sharedCtx, cancel := detachContext(ctx)
+	ic := &inflightCall{
+		done:    make(chan struct{}),
+		waiters: 1,
+		cancel:  cancel,
+	}
+	g.calls[key] = ic
+	g.mu.Unlock()
+
+	go func() {
+		defer cancel()
+
+		resp, err := fn(sharedCtx)
+
+		g.mu.Lock()
+		ic.resp, ic.err = resp, err
+		g.forget(key, ic)
+		g.mu.Unlock()
+
+		close(ic.done)
+	}()
+
+	return g.wait(ctx, key, ic)
+}
+
+func (g *inflightGroup) wait(ctx context.Context, key string, ic *inflightCall) (*response, error) {
+	select {
+	case <-ic.done:
+		return ic.resp, ic.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		ic.waiters--
+		if ic.waiters == 0 {
+			ic.cancel()
+			g.forget(key, ic)
+		}
+		g.mu.Unlock()
+
+		return nil, fmt.Errorf("failed to do request: %w", ctx.Err())
+	}
+}
+
+// forget removes ic from the group unless a newer call already replaced it.
+// It must be called with g.mu held.
+func (g *inflightGroup) forget(key string, ic *inflightCall) {
+	if g.calls[key] == ic {
+		delete(g.calls, key)
+	}
+}
+
+// detachContext returns a context with the values and the deadline of ctx but not its cancellation.
+func detachContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	detached := context.WithoutCancel(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(detached, deadline)
+	}
+
+	return context.WithCancel(detached)
+}
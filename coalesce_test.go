@@ -0,0 +1,134 @@
+package cielogo_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sealtv/cielogo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoalescing_SharesIdenticalRequests(t *testing.T) {
+	server := newCachedServer(t)
+	release := server.Hold(t)
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL), cielogo.WithRequestCoalescing())
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.GetTokenMetadataV1(context.Background(), metadataRequest)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "USDC", resp.Symbol)
+			}
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	release()
+	wg.Wait()
+
+	server.AssertRequestCount(t, metadataQueryPath, 1)
+	assert.Equal(t, 1, client.CreditUsage().Calls)
+}
+
+func TestCoalescing_CanceledWaiterDoesNotAbortSharedRequest(t *testing.T) {
+	server := newCachedServer(t)
+	release := server.Hold(t)
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL), cielogo.WithRequestCoalescing())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetTokenMetadataV1(ctx, metadataRequest)
+		firstErr <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+
+	secondErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetTokenMetadataV1(context.Background(), metadataRequest)
+		secondErr <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	require.ErrorIs(t, <-firstErr, context.Canceled)
+
+	release()
+	require.NoError(t, <-secondErr)
+	server.AssertRequestCount(t, metadataQueryPath, 1)
+	assert.Zero(t, server.CanceledCount())
+}
+
+func TestCoalescing_LastWaiterCancelsRequest(t *testing.T) {
+	server := newCachedServer(t)
+	server.Hold(t)
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL), cielogo.WithRequestCoalescing())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetTokenMetadataV1(ctx, metadataRequest)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.Eventually(t, func() bool { return server.CanceledCount() == 1 }, time.Second, 5*time.Millisecond,
+		"shared request was not canceled after the last waiter left")
+}
+
+func TestCoalescing_SharedRequestKeepsFirstDeadline(t *testing.T) {
+	server := newCachedServer(t)
+	server.Hold(t)
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL), cielogo.WithRequestCoalescing())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetTokenMetadataV1(ctx, metadataRequest)
+		firstErr <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	_, err := client.GetTokenMetadataV1(context.Background(), metadataRequest)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "the shared request ends at the first caller's deadline")
+	require.ErrorIs(t, <-firstErr, context.DeadlineExceeded)
+	server.AssertRequestCount(t, metadataQueryPath, 1)
+}
+
+func TestCoalescing_DifferentHeadersAreNotShared(t *testing.T) {
+	server := newCachedServer(t)
+	release := server.Hold(t)
+	type tenantKey struct{}
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRequestCoalescing(),
+		cielogo.WithInterceptors(func(ctx context.Context, call *cielogo.Call, next cielogo.Invoker) error {
+			tenant, _ := ctx.Value(tenantKey{}).(string)
+			call.Header = http.Header{"X-Tenant": []string{tenant}}
+			return next(ctx, call)
+		}),
+	)
+
+	var wg sync.WaitGroup
+	for _, tenant := range []string{"a", "b", "a"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetTokenMetadataV1(context.WithValue(context.Background(), tenantKey{}, tenant), metadataRequest)
+			assert.NoError(t, err)
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	release()
+	wg.Wait()
+
+	server.AssertRequestCount(t, metadataQueryPath, 2)
+}
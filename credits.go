@@ -0,0 +1,228 @@
+package cielogo
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sealtv/cielogo/api/apiv1"
+)
+
+// Credit budget windows reported by BudgetExceededError.
+const (
+	CreditWindowMinute = "minute"
+	CreditWindowDay    = "day"
+	CreditWindowTotal  = "total"
+)
+
+// CreditBudget limits the estimated credits the client may spend.
+// Zero fields are unlimited. Calls that would exceed a limit fail with *BudgetExceededError
+// before they are sent.
+type CreditBudget struct {
+	// PerMinute limits credits spent over any sliding one-minute window.
+	PerMinute int
+	// PerDay limits credits spent over any sliding 24-hour window.
+	PerDay int
+	// Total limits credits spent over the lifetime of the client.
+	Total int
+}
+
+// WithCreditBudget sets a budget enforced on the estimated credit cost of every request
+func WithCreditBudget(budget CreditBudget) ClientOption {
+	return func(c *Client) {
+		c.credits.budget = budget
+	}
+}
+
+// BudgetExceededError is returned when a request would exceed the configured CreditBudget.
+type BudgetExceededError struct {
+	// Endpoint is the endpoint of the rejected call.
+	Endpoint string
+	// Window is the budget window that would be exceeded, see the CreditWindow constants.
+	Window string
+	// Limit is the configured limit for the window.
+	Limit int
+	// Used is the number of credits already spent in the window.
+	Used int
+	// Requested is the estimated cost of the rejected call.
+	Requested int
+}
+
+func (e *BudgetExceededError) Error() string {
+	return fmt.Sprintf("credit budget exceeded for %s: %d of %d credits per %s used, %d requested",
+		e.Endpoint, e.Used, e.Limit, e.Window, e.Requested)
+}
+
+// EndpointCreditUsage contains the estimated credits spent on a single endpoint.
+type EndpointCreditUsage struct {
+	Calls   int
+	Credits int
+}
+
+// CreditUsage is a snapshot of the estimated credits spent by a client.
+type CreditUsage struct {
+	// Calls is the number of requests sent.
+	Calls int
+	// Total is the number of credits spent over the lifetime of the client.
+	Total int
+	// LastMinute is the number of credits spent during the last minute.
+	LastMinute int
+	// LastDay is the number of credits spent during the last 24 hours.
+	LastDay int
+	// ByEndpoint breaks the usage down per endpoint name.
+	ByEndpoint map[string]EndpointCreditUsage
+}
+
+// CreditUsage returns the estimated credits spent by the client so far.
+// Estimates are based on the documented cost of each endpoint; every request sent,
+// including retries and polls, is counted.
+func (c *Client) CreditUsage() CreditUsage {
+	return c.credits.usage()
+}
+
+type creditEntry struct {
+	at      time.Time
+	credits int
+}
+
+// creditMeter records estimated credit spending and enforces a CreditBudget.
+type creditMeter struct {
+	mu         sync.Mutex
+	budget     CreditBudget
+	calls      int
+	total      int
+	byEndpoint map[string]EndpointCreditUsage
+	// recent holds the spending of the last 24 hours, oldest first.
+	recent []creditEntry
+	now    func() time.Time
+}
+
+func newCreditMeter() *creditMeter {
+	return &creditMeter{
+		byEndpoint: make(map[string]EndpointCreditUsage),
+		now:        time.Now,
+	}
+}
+
+// check returns a *BudgetExceededError if the budget does not allow the call, without recording it.
+func (m *creditMeter) check(call *Call) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.prune(now)
+
+	return m.exceeded(call, now)
+}
+
+// spend checks the budget for a call and records its cost if the budget allows it.
+func (m *creditMeter) spend(call *Call) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.prune(now)
+
+	if err := m.exceeded(call, now); err != nil {
+		return err
+	}
+
+	m.calls++
+	m.total += call.Credits
+	usage := m.byEndpoint[call.Endpoint]
+	usage.Calls++
+	usage.Credits += call.Credits
+	m.byEndpoint[call.Endpoint] = usage
+	m.recent = append(m.recent, creditEntry{at: now, credits: call.Credits})
+
+	return nil
+}
+
+// exceeded returns a *BudgetExceededError if the call would exceed the budget.
+// It must be called with m.mu held.
+func (m *creditMeter) exceeded(call *Call, now time.Time) error {
+	limits := []struct {
+		window string
+		limit  int
+		used   int
+	}{
+		{CreditWindowMinute, m.budget.PerMinute, m.spentSince(now.Add(-time.Minute))},
+		{CreditWindowDay, m.budget.PerDay, m.spentSince(now.Add(-24 * time.Hour))},
+		{CreditWindowTotal, m.budget.Total, m.total},
+	}
+	for _, l := range limits {
+		if l.limit > 0 && l.used+call.Credits > l.limit {
+			return &BudgetExceededError{
+				Endpoint:  call.Endpoint,
+				Window:    l.window,
+				Limit:     l.limit,
+				Used:      l.used,
+				Requested: call.Credits,
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *creditMeter) usage() CreditUsage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.prune(now)
+
+	byEndpoint := make(map[string]EndpointCreditUsage, len(m.byEndpoint))
+	for endpoint, usage := range m.byEndpoint {
+		byEndpoint[endpoint] = usage
+	}
+
+	return CreditUsage{
+		Calls:      m.calls,
+		Total:      m.total,
+		LastMinute: m.spentSince(now.Add(-time.Minute)),
+		LastDay:    m.spentSince(now.Add(-24 * time.Hour)),
+		ByEndpoint: byEndpoint,
+	}
+}
+
+// prune drops entries older than the largest window. It must be called with m.mu held.
+func (m *creditMeter) prune(now time.Time) {
+	cutoff := now.Add(-24 * time.Hour)
+
+	i := 0
+	for i < len(m.recent) && !m.recent[i].at.After(cutoff) {
+		i++
+	}
+	m.recent = m.recent[i:]
+}
+
+// spentSince sums the credits spent after t. It must be called with m.mu held.
+func (m *creditMeter) spentSince(t time.Time) int {
+	sum := 0
+	for i := len(m.recent) - 1; i >= 0 && m.recent[i].at.After(t); i-- {
+		sum += m.recent[i].credits
+	}
+
+	return sum
+}
+
+// feedCredits returns the cost of a feed request: 5 credits, 3 when filtered by wallet,
+// doubled when market cap data is included.
+func feedCredits(req *apiv1.FeedRequest) int {
+	credits := 5
+	if req.Wallet != "" {
+		credits = 3
+	}
+
+	if req.IncludeMarketCap != nil && *req.IncludeMarketCap {
+		credits *= 2
+	}
+
+	return credits
+}
+
+// portfolioV2Credits returns the cost of a V2 portfolio request: 20 credits per wallet.
+func portfolioV2Credits(req *apiv1.WalletPortfolioV2Request) int {
+	return 20 * max(len(req.Wallets), 1)
+}
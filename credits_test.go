@@ -0,0 +1,93 @@
+package cielogo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreditUsage_TracksEstimatedCredits(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	server.SetResponse("/v1/feed/?includeMarketCap=true&wallet=0x123", api.CieloResponse[apiv1.FeedResponse]{})
+	server.SetResponse("/v2/portfolio?wallet=w1%2Cw2%2Cw3", api.CieloResponse[apiv1.WalletPortfolioV2Response]{})
+	server.SetResponse("/v1/token/price?chain=solana&token_address=token", api.CieloResponse[apiv1.TokenPriceResponse]{})
+
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	_, err := client.GetFeedV1(ctx, &apiv1.FeedRequest{Wallet: "0x123", IncludeMarketCap: apiv1.ToRef(true)})
+	require.NoError(t, err)
+	_, err = client.GetWalletPortfolioV2(ctx, &apiv1.WalletPortfolioV2Request{Wallets: []string{"w1", "w2", "w3"}})
+	require.NoError(t, err)
+	for range 2 {
+		_, err = client.GetTokenPriceV1(ctx, &apiv1.TokenPriceRequest{Chain: apiv1.TokenChainSolana, TokenAddress: "token"})
+		require.NoError(t, err)
+	}
+
+	usage := client.CreditUsage()
+	assert.Equal(t, 4, usage.Calls)
+	assert.Equal(t, 6+60+2, usage.Total)
+	assert.Equal(t, usage.Total, usage.LastMinute)
+	assert.Equal(t, usage.Total, usage.LastDay)
+	assert.Equal(t, cielogo.EndpointCreditUsage{Calls: 1, Credits: 6}, usage.ByEndpoint[cielogo.EndpointGetFeedV1])
+	assert.Equal(t, cielogo.EndpointCreditUsage{Calls: 1, Credits: 60}, usage.ByEndpoint[cielogo.EndpointGetWalletPortfolioV2])
+	assert.Equal(t, cielogo.EndpointCreditUsage{Calls: 2, Credits: 2}, usage.ByEndpoint[cielogo.EndpointGetTokenPriceV1])
+}
+
+func TestCreditBudget_RejectsCallsBeforeSending(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	server.SetResponse("/v1/wallet123/portfolio", api.CieloResponse[apiv1.WalletPortfolioResponse]{})
+
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithCreditBudget(cielogo.CreditBudget{Total: 30}),
+	)
+	ctx := context.Background()
+
+	_, err := client.GetWalletPortfolioV1(ctx, "wallet123")
+	require.NoError(t, err)
+
+	_, err = client.GetWalletPortfolioV1(ctx, "wallet123")
+	require.Error(t, err)
+
+	var budgetErr *cielogo.BudgetExceededError
+	require.ErrorAs(t, err, &budgetErr)
+	assert.Equal(t, cielogo.EndpointGetWalletPortfolioV1, budgetErr.Endpoint)
+	assert.Equal(t, cielogo.CreditWindowTotal, budgetErr.Window)
+	assert.Equal(t, 30, budgetErr.Limit)
+	assert.Equal(t, 20, budgetErr.Used)
+	assert.Equal(t, 20, budgetErr.Requested)
+
+	server.AssertRequestCount(t, "/v1/wallet123/portfolio", 1)
+	assert.Equal(t, 20, client.CreditUsage().Total)
+}
+
+func TestCreditBudget_PerMinute(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	server.SetResponse("/v1/token/stats?chain=solana&token_address=token", api.CieloResponse[apiv1.TokenStatsResponse]{})
+
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithCreditBudget(cielogo.CreditBudget{PerMinute: 10, PerDay: 1000}),
+	)
+	ctx := context.Background()
+	req := &apiv1.TokenStatsRequest{Chain: apiv1.TokenChainSolana, TokenAddress: "token"}
+
+	for range 3 {
+		_, err := client.GetTokenStatsV1(ctx, req)
+		require.NoError(t, err)
+	}
+
+	_, err := client.GetTokenStatsV1(ctx, req)
+
+	var budgetErr *cielogo.BudgetExceededError
+	require.ErrorAs(t, err, &budgetErr)
+	assert.Equal(t, cielogo.CreditWindowMinute, budgetErr.Window)
+	assert.Equal(t, 9, budgetErr.Used)
+}
@@ -0,0 +1,228 @@
+// Package fake provides an in-memory implementation of cielogo.API for unit tests.
+//
+// Responses are programmed per endpoint, using the cielogo.Endpoint constants:
+//
+//	client := fake.New()
+//	client.SetResponse(cielogo.EndpointGetTokenPriceV1, &apiv1.TokenPriceResponse{Price: 1.5})
+//	client.SetError(cielogo.EndpointGetFeedV1, api.ErrRateLimited)
+//
+//	svc := NewService(client) // depends on cielogo.API
+//	...
+//	calls := client.CallsTo(cielogo.EndpointGetTokenPriceV1)
+//
+// Endpoints without a programmed response return the zero value of their result
+// (an empty, non-nil response for pointer results) and no error.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/sealtv/cielogo"
+)
+
+// EndpointDialWebsocket identifies DialWebsocket calls in SetError and the recorded calls.
+const EndpointDialWebsocket = "DialWebsocket"
+
+// Call is a call recorded by the fake client.
+type Call struct {
+	// Endpoint is the name of the called method, see the cielogo.Endpoint constants.
+	Endpoint string
+	// Args holds the arguments of the call after the context, e.g. the request object.
+	Args []any
+}
+
+// Handler computes the response to a call. It must return a value of the method's result type
+// (e.g. *apiv1.FeedResponse for GetFeedV1), or nil.
+type Handler func(ctx context.Context, call Call) (any, error)
+
+// Client is an in-memory cielogo.API. It is safe for concurrent use.
+type Client struct {
+	mu        sync.Mutex
+	calls     []Call
+	responses map[string]any
+	errors    map[string]error
+	handlers  map[string]Handler
+	websocket *Websocket
+
+	statsNotReady int
+}
+
+var _ cielogo.API = (*Client)(nil)
+
+// New returns a fake client without programmed responses.
+func New() *Client {
+	return &Client{
+		responses: make(map[string]any),
+		errors:    make(map[string]error),
+		handlers:  make(map[string]Handler),
+		websocket: NewWebsocket(),
+	}
+}
+
+// SetResponse makes every call to the endpoint return resp, which must have the method's result type.
+// Each call gets its own shallow copy of resp, so code under test may modify the returned struct or
+// slice; the slices and maps it references are still shared. Use SetHandler to build independent
+// values per call.
+func (f *Client) SetResponse(endpoint string, resp any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.responses[endpoint] = resp
+}
+
+// SetError makes every call to the endpoint fail with err. A nil err removes the injected error.
+// Injected errors take precedence over responses and handlers.
+func (f *Client) SetError(endpoint string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err == nil {
+		delete(f.errors, endpoint)
+		return
+	}
+
+	f.errors[endpoint] = err
+}
+
+// SetHandler computes the responses to the endpoint with h. Handlers take precedence over responses.
+func (f *Client) SetHandler(endpoint string, h Handler) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.handlers[endpoint] = h
+}
+
+// SetTradingStatsNotReady makes the next polls calls to GetTradingStatsV1, or polls of the handles
+// returned by StartTradingStatsV1, answer that the stats are not ready yet with an error matching
+// api.ErrDataNotReady. Later calls return the programmed response.
+func (f *Client) SetTradingStatsNotReady(polls int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.statsNotReady = polls
+}
+
+// Calls returns every recorded call, in order.
+func (f *Client) Calls() []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Call(nil), f.calls...)
+}
+
+// CallsTo returns the recorded calls to the endpoint, in order.
+func (f *Client) CallsTo(endpoint string) []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var calls []Call
+	for _, call := range f.calls {
+		if call.Endpoint == endpoint {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// Reset forgets the recorded calls and every programmed response, error and handler.
+func (f *Client) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = nil
+	clear(f.responses)
+	clear(f.errors)
+	clear(f.handlers)
+	f.statsNotReady = 0
+}
+
+// Websocket returns the connection returned by DialWebsocket.
+func (f *Client) Websocket() *Websocket {
+	return f.websocket
+}
+
+// DialWebsocket returns the fake connection, see Websocket. Options are ignored.
+func (f *Client) DialWebsocket(ctx context.Context, _ ...cielogo.WebsocketOption) (cielogo.WebsocketConn, error) {
+	if _, err := f.record(ctx, Call{Endpoint: EndpointDialWebsocket}); err != nil {
+		return nil, err
+	}
+
+	return f.websocket, nil
+}
+
+// record records the call and returns the programmed result, if any.
+func (f *Client) record(ctx context.Context, call Call) (any, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, call)
+	err := f.errors[call.Endpoint]
+	handler := f.handlers[call.Endpoint]
+	resp := f.responses[call.Endpoint]
+	f.mu.Unlock()
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, fmt.Errorf("fake: %s: %w", call.Endpoint, ctxErr)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if handler != nil {
+		return handler(ctx, call)
+	}
+
+	return shallowCopy(resp), nil
+}
+
+// shallowCopy returns a copy of the value pointed to by a pointer, or of the elements of a slice.
+// Other values are returned as is.
+func shallowCopy(v any) any {
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Kind() == reflect.Pointer && !rv.IsNil():
+		c := reflect.New(rv.Elem().Type())
+		c.Elem().Set(rv.Elem())
+		return c.Interface()
+	case rv.Kind() == reflect.Slice && !rv.IsNil():
+		c := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		reflect.Copy(c, rv)
+		return c.Interface()
+	default:
+		return v
+	}
+}
+
+// invoke records the call and returns its result as T.
+func invoke[T any](ctx context.Context, f *Client, endpoint string, args ...any) (T, error) {
+	var zero T
+
+	resp, err := f.record(ctx, Call{Endpoint: endpoint, Args: args})
+	if err != nil {
+		return zero, err
+	}
+
+	if resp == nil {
+		return newZero[T](), nil
+	}
+
+	result, ok := resp.(T)
+	if !ok {
+		return zero, fmt.Errorf("fake: response for %s has type %T, want %T", endpoint, resp, zero)
+	}
+
+	return result, nil
+}
+
+// newZero returns the zero value of T, or a pointer to a new zero value when T is a pointer type.
+func newZero[T any]() T {
+	var zero T
+	if t := reflect.TypeFor[T](); t.Kind() == reflect.Pointer {
+		return reflect.New(t.Elem()).Interface().(T) //nolint:errcheck // the value has type T by construction
+	}
+
+	return zero
+}
@@ -0,0 +1,197 @@
+package fake_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// priceOf is code under test written against the API interface.
+func priceOf(ctx context.Context, client cielogo.TokenAPI, token string) (float64, error) {
+	resp, err := client.GetTokenPriceV1(ctx, &apiv1.TokenPriceRequest{Chain: apiv1.TokenChainSolana, TokenAddress: token})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Price, nil
+}
+
+func TestClient_ProgrammedResponse(t *testing.T) {
+	client := fake.New()
+	client.SetResponse(cielogo.EndpointGetTokenPriceV1, &apiv1.TokenPriceResponse{Price: 1.5})
+
+	price, err := priceOf(context.Background(), client, "token")
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, price, 0.0001)
+
+	calls := client.CallsTo(cielogo.EndpointGetTokenPriceV1)
+	require.Len(t, calls, 1)
+	req, ok := calls[0].Args[0].(*apiv1.TokenPriceRequest)
+	require.True(t, ok)
+	assert.Equal(t, "token", req.TokenAddress)
+}
+
+func TestClient_ResponsesAreCopiedPerCall(t *testing.T) {
+	client := fake.New()
+	client.SetResponse(cielogo.EndpointGetTokenPriceV1, &apiv1.TokenPriceResponse{Price: 1.5})
+	client.SetResponse(cielogo.EndpointGetUserWalletsListsV1, []apiv1.WalletList{{ID: 1}})
+	ctx := context.Background()
+
+	first, err := client.GetTokenPriceV1(ctx, &apiv1.TokenPriceRequest{})
+	require.NoError(t, err)
+	first.Price = 0
+
+	second, err := client.GetTokenPriceV1(ctx, &apiv1.TokenPriceRequest{})
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, second.Price, 0.0001)
+
+	lists, err := client.GetUserWalletsListsV1(ctx)
+	require.NoError(t, err)
+	lists[0].ID = 2
+
+	lists, err = client.GetUserWalletsListsV1(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), lists[0].ID)
+}
+
+func TestClient_ZeroValueByDefault(t *testing.T) {
+	client := fake.New()
+	ctx := context.Background()
+
+	feed, err := client.GetFeedV1(ctx, &apiv1.FeedRequest{})
+	require.NoError(t, err)
+	assert.NotNil(t, feed)
+
+	lists, err := client.GetUserWalletsListsV1(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, lists)
+
+	require.NoError(t, client.DeleteWalletsListV1(ctx, 7, true))
+	calls := client.Calls()
+	require.Len(t, calls, 3)
+	assert.Equal(t, fake.Call{Endpoint: cielogo.EndpointDeleteWalletsListV1, Args: []any{int64(7), true}}, calls[2])
+}
+
+func TestClient_ErrorInjection(t *testing.T) {
+	client := fake.New()
+	client.SetResponse(cielogo.EndpointGetWalletByAddressV1, &apiv1.TrackedWallet{Wallet: "0x1"})
+	client.SetError(cielogo.EndpointGetWalletByAddressV1, &api.Error{StatusCode: 404, Message: "not found"})
+	ctx := context.Background()
+
+	_, err := client.GetWalletByAddressV1(ctx, "0x1")
+	require.ErrorIs(t, err, api.ErrNotFound)
+
+	client.SetError(cielogo.EndpointGetWalletByAddressV1, nil)
+	wallet, err := client.GetWalletByAddressV1(ctx, "0x1")
+	require.NoError(t, err)
+	assert.Equal(t, "0x1", wallet.Wallet)
+}
+
+func TestClient_Handler(t *testing.T) {
+	client := fake.New()
+	client.SetHandler(cielogo.EndpointGetWalletPortfolioV1, func(_ context.Context, call fake.Call) (any, error) {
+		if call.Args[0] == "unknown" {
+			return nil, api.ErrNotFound
+		}
+		return &apiv1.WalletPortfolioResponse{TotalUSDValue: 42}, nil
+	})
+	ctx := context.Background()
+
+	portfolio, err := client.GetWalletPortfolioV1(ctx, "0xabc")
+	require.NoError(t, err)
+	assert.InDelta(t, 42, portfolio.TotalUSDValue, 0.0001)
+
+	_, err = client.GetWalletPortfolioV1(ctx, "unknown")
+	require.ErrorIs(t, err, api.ErrNotFound)
+}
+
+func TestClient_TradingStatsNotReady(t *testing.T) {
+	client := fake.New()
+	client.SetResponse(cielogo.EndpointGetTradingStatsV1, &apiv1.TradingStatsResponse{TotalTrades: 7})
+	client.SetTradingStatsNotReady(2)
+	ctx := context.Background()
+	req := &apiv1.TradingStatsRequest{Wallet: "0xabc"}
+
+	var pnl cielogo.PnLAPI = client
+	pending, err := pnl.StartTradingStatsV1(ctx, req)
+	require.NoError(t, err)
+
+	_, ready, err := pending.Check(ctx)
+	require.NoError(t, err)
+	assert.False(t, ready)
+
+	stats, ready, err := pending.Check(ctx)
+	require.NoError(t, err)
+	require.True(t, ready)
+	assert.Equal(t, 7, stats.TotalTrades)
+	assert.Len(t, client.CallsTo(cielogo.EndpointGetTradingStatsV1), 3)
+
+	client.SetTradingStatsNotReady(1)
+	stats, err = pnl.GetTradingStatsV1(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 7, stats.TotalTrades)
+	assert.Len(t, client.CallsTo(cielogo.EndpointGetTradingStatsV1), 5)
+}
+
+func TestClient_WrongResponseType(t *testing.T) {
+	client := fake.New()
+	client.SetResponse(cielogo.EndpointGetTokenPriceV1, apiv1.TokenPriceResponse{Price: 1})
+
+	_, err := client.GetTokenPriceV1(context.Background(), &apiv1.TokenPriceRequest{})
+	require.ErrorContains(t, err, "want *apiv1.TokenPriceResponse")
+}
+
+func TestClient_CanceledContext(t *testing.T) {
+	client := fake.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetTokenPriceV1(ctx, &apiv1.TokenPriceRequest{})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestWebsocket(t *testing.T) {
+	client := fake.New()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var dialer cielogo.WebsocketAPI = client
+	ws, err := dialer.DialWebsocket(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, ws.SendCommand(&apiv1.FeedSubscribeCmd{}))
+	assert.Equal(t, []apiv1.WebSocketsCommand{&apiv1.FeedSubscribeCmd{}}, client.Websocket().Commands())
+
+	events := make(chan apiv1.WSEvent, 1)
+	done := make(chan error, 1)
+	go func() { done <- ws.RunListener(ctx, events) }()
+
+	tx := apiv1.TxEvent{TxType: apiv1.TxTypeSwap, Wallet: "0x1"}
+	require.NoError(t, client.Websocket().Push(ctx, apiv1.WSEvent{Type: apiv1.TxEventType, Data: tx}))
+	assert.Equal(t, tx, (<-events).Data)
+
+	ws.Close()
+	require.NoError(t, <-done)
+	assert.Error(t, ws.SendCommand(&apiv1.FeedUnsubscribeCmd{}))
+}
+
+func TestWebsocket_Errors(t *testing.T) {
+	client := fake.New()
+	dialErr := errors.New("dial failed")
+	client.SetError(fake.EndpointDialWebsocket, dialErr)
+
+	_, err := client.DialWebsocket(context.Background())
+	require.ErrorIs(t, err, dialErr)
+
+	sendErr := errors.New("send failed")
+	client.Websocket().SetSendError(sendErr)
+	require.ErrorIs(t, client.Websocket().SendCommand(&apiv1.FeedSubscribeCmd{}), sendErr)
+}
@@ -0,0 +1,195 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+)
+
+// statsMaxWait bounds how long the trading stats handles of the fake wait for stats that never get ready.
+const statsMaxWait = time.Second
+
+// GetFeedV1 implements cielogo.API.
+func (f *Client) GetFeedV1(ctx context.Context, req *apiv1.FeedRequest) (*apiv1.FeedResponse, error) {
+	return invoke[*apiv1.FeedResponse](ctx, f, cielogo.EndpointGetFeedV1, req)
+}
+
+// GetNftsPnlV1 implements cielogo.API.
+func (f *Client) GetNftsPnlV1(ctx context.Context, req *apiv1.NftsPnLRequest) (*apiv1.NftsPnLResponse, error) {
+	return invoke[*apiv1.NftsPnLResponse](ctx, f, cielogo.EndpointGetNftsPnlV1, req)
+}
+
+// GetTokensPnlV1 implements cielogo.API.
+func (f *Client) GetTokensPnlV1(ctx context.Context, req *apiv1.TokensPnLRequest) (*apiv1.TokensPnLResponse, error) {
+	return invoke[*apiv1.TokensPnLResponse](ctx, f, cielogo.EndpointGetTokensPnlV1, req)
+}
+
+// GetAggregatedTokenPnLV1 implements cielogo.API.
+func (f *Client) GetAggregatedTokenPnLV1(ctx context.Context, req *apiv1.AggregatedTokenPnLRequest) (*apiv1.AggregatedTokenPnLResponse, error) {
+	return invoke[*apiv1.AggregatedTokenPnLResponse](ctx, f, cielogo.EndpointGetAggregatedTokenPnLV1, req)
+}
+
+// GetTradingStatsV1 implements cielogo.API. Like the real client, it polls until the stats are
+// ready, see SetTradingStatsNotReady.
+func (f *Client) GetTradingStatsV1(ctx context.Context, req *apiv1.TradingStatsRequest) (*apiv1.TradingStatsResponse, error) {
+	pending, err := f.StartTradingStatsV1(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return pending.Wait(ctx)
+}
+
+// StartTradingStatsV1 implements cielogo.API. Every poll of the returned handle is recorded as a
+// call to cielogo.EndpointGetTradingStatsV1; the handle polls again without delay and Wait gives
+// up after statsMaxWait.
+func (f *Client) StartTradingStatsV1(ctx context.Context, req *apiv1.TradingStatsRequest) (*cielogo.PendingTradingStats, error) {
+	fetch := func(ctx context.Context) (*apiv1.TradingStatsResponse, error) {
+		return f.pollTradingStats(ctx, req)
+	}
+
+	return cielogo.StartTradingStats(ctx, fetch, 0, statsMaxWait)
+}
+
+// pollTradingStats answers one trading stats poll.
+func (f *Client) pollTradingStats(ctx context.Context, req *apiv1.TradingStatsRequest) (*apiv1.TradingStatsResponse, error) {
+	f.mu.Lock()
+	notReady := f.statsNotReady > 0
+	if notReady {
+		f.statsNotReady--
+	}
+	f.mu.Unlock()
+
+	if !notReady {
+		return invoke[*apiv1.TradingStatsResponse](ctx, f, cielogo.EndpointGetTradingStatsV1, req)
+	}
+
+	if _, err := f.record(ctx, Call{Endpoint: cielogo.EndpointGetTradingStatsV1, Args: []any{req}}); err != nil {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("fake: %s: %w", cielogo.EndpointGetTradingStatsV1, api.ErrDataNotReady)
+}
+
+// GetRelatedWalletsV1 implements cielogo.API.
+func (f *Client) GetRelatedWalletsV1(ctx context.Context, req *apiv1.RelatedWalletsRequest) (*apiv1.RelatedWalletsResponse, error) {
+	return invoke[*apiv1.RelatedWalletsResponse](ctx, f, cielogo.EndpointGetRelatedWalletsV1, req)
+}
+
+// GetWalletTagsV1 implements cielogo.API.
+func (f *Client) GetWalletTagsV1(ctx context.Context, req *apiv1.GetWalletTagsRequest) (*apiv1.GetWalletTagsResponse, error) {
+	return invoke[*apiv1.GetWalletTagsResponse](ctx, f, cielogo.EndpointGetWalletTagsV1, req)
+}
+
+// GetWalletsTagsV1 implements cielogo.API.
+func (f *Client) GetWalletsTagsV1(ctx context.Context, req *apiv1.GetWalletsTagsRequest) ([]apiv1.WalletTags, error) {
+	return invoke[[]apiv1.WalletTags](ctx, f, cielogo.EndpointGetWalletsTagsV1, req)
+}
+
+// GetWalletsByTagV1 implements cielogo.API.
+func (f *Client) GetWalletsByTagV1(ctx context.Context, req *apiv1.GetWalletsByTagRequest) (*apiv1.GetWalletsByTagResponse, error) {
+	return invoke[*apiv1.GetWalletsByTagResponse](ctx, f, cielogo.EndpointGetWalletsByTagV1, req)
+}
+
+// GetAllWalletsListV1 implements cielogo.API.
+func (f *Client) GetAllWalletsListV1(ctx context.Context, req *apiv1.GetAllWalletsListsRequest) (*apiv1.GetAllWalletsListsResponse, error) {
+	return invoke[*apiv1.GetAllWalletsListsResponse](ctx, f, cielogo.EndpointGetAllWalletsListV1, req)
+}
+
+// GetUserWalletsListsV1 implements cielogo.API.
+func (f *Client) GetUserWalletsListsV1(ctx context.Context) ([]apiv1.WalletList, error) {
+	return invoke[[]apiv1.WalletList](ctx, f, cielogo.EndpointGetUserWalletsListsV1)
+}
+
+// AddWalletsListV1 implements cielogo.API.
+func (f *Client) AddWalletsListV1(ctx context.Context, req *apiv1.AddWalletsListRequest) (*apiv1.WalletList, error) {
+	return invoke[*apiv1.WalletList](ctx, f, cielogo.EndpointAddWalletsListV1, req)
+}
+
+// UpdateWalletsListV1 implements cielogo.API.
+func (f *Client) UpdateWalletsListV1(ctx context.Context, req *apiv1.UpdateWalletsListRequest) (*apiv1.WalletList, error) {
+	return invoke[*apiv1.WalletList](ctx, f, cielogo.EndpointUpdateWalletsListV1, req)
+}
+
+// DeleteWalletsListV1 implements cielogo.API.
+func (f *Client) DeleteWalletsListV1(ctx context.Context, listID int64, deleteWallets bool) error {
+	_, err := f.record(ctx, Call{Endpoint: cielogo.EndpointDeleteWalletsListV1, Args: []any{listID, deleteWallets}})
+
+	return err
+}
+
+// ToggleFollowWalletsListV1 implements cielogo.API.
+func (f *Client) ToggleFollowWalletsListV1(ctx context.Context, listID int64) (*apiv1.ToggleFollowWalletsListResponce, error) {
+	return invoke[*apiv1.ToggleFollowWalletsListResponce](ctx, f, cielogo.EndpointToggleFollowWalletsListV1, listID)
+}
+
+// GetTrackedWalletsV1 implements cielogo.API.
+func (f *Client) GetTrackedWalletsV1(ctx context.Context, req *apiv1.GetTrackedWalletsRequest) (*apiv1.GetTrackedWalletsResponse, error) {
+	return invoke[*apiv1.GetTrackedWalletsResponse](ctx, f, cielogo.EndpointGetTrackedWalletsV1, req)
+}
+
+// AddTrackedWalletsV1 implements cielogo.API.
+func (f *Client) AddTrackedWalletsV1(ctx context.Context, req *apiv1.AddTrackedWalletRequest) (*apiv1.TrackedWallet, error) {
+	return invoke[*apiv1.TrackedWallet](ctx, f, cielogo.EndpointAddTrackedWalletsV1, req)
+}
+
+// RemoveTrackedWalletsV1 implements cielogo.API.
+func (f *Client) RemoveTrackedWalletsV1(ctx context.Context, req *apiv1.RemoveTrackedWalletsRequest) error {
+	_, err := f.record(ctx, Call{Endpoint: cielogo.EndpointRemoveTrackedWalletsV1, Args: []any{req}})
+
+	return err
+}
+
+// GetWalletByAddressV1 implements cielogo.API.
+func (f *Client) GetWalletByAddressV1(ctx context.Context, wallet string) (*apiv1.TrackedWallet, error) {
+	return invoke[*apiv1.TrackedWallet](ctx, f, cielogo.EndpointGetWalletByAddressV1, wallet)
+}
+
+// UpdateTrackedWalletV1 implements cielogo.API.
+func (f *Client) UpdateTrackedWalletV1(ctx context.Context, walletID int64, req *apiv1.UpdateTrackedWalletRequest) (*apiv1.TrackedWallet, error) {
+	return invoke[*apiv1.TrackedWallet](ctx, f, cielogo.EndpointUpdateTrackedWalletV1, walletID, req)
+}
+
+// UpdateTrackedWalletV2 implements cielogo.API.
+func (f *Client) UpdateTrackedWalletV2(ctx context.Context, wallet string, req *apiv1.UpdateTrackedWalletV2Request) (*apiv1.TrackedWallet, error) {
+	return invoke[*apiv1.TrackedWallet](ctx, f, cielogo.EndpointUpdateTrackedWalletV2, wallet, req)
+}
+
+// GetTelegramBotsV1 implements cielogo.API.
+func (f *Client) GetTelegramBotsV1(ctx context.Context) (*apiv1.GetTelegramBotsResponse, error) {
+	return invoke[*apiv1.GetTelegramBotsResponse](ctx, f, cielogo.EndpointGetTelegramBotsV1)
+}
+
+// GetWalletPortfolioV1 implements cielogo.API.
+func (f *Client) GetWalletPortfolioV1(ctx context.Context, wallet string) (*apiv1.WalletPortfolioResponse, error) {
+	return invoke[*apiv1.WalletPortfolioResponse](ctx, f, cielogo.EndpointGetWalletPortfolioV1, wallet)
+}
+
+// GetWalletPortfolioV2 implements cielogo.API.
+func (f *Client) GetWalletPortfolioV2(ctx context.Context, req *apiv1.WalletPortfolioV2Request) (*apiv1.WalletPortfolioV2Response, error) {
+	return invoke[*apiv1.WalletPortfolioV2Response](ctx, f, cielogo.EndpointGetWalletPortfolioV2, req)
+}
+
+// GetTokenMetadataV1 implements cielogo.API.
+func (f *Client) GetTokenMetadataV1(ctx context.Context, req *apiv1.TokenMetadataRequest) (*apiv1.TokenMetadataResponse, error) {
+	return invoke[*apiv1.TokenMetadataResponse](ctx, f, cielogo.EndpointGetTokenMetadataV1, req)
+}
+
+// GetTokenPriceV1 implements cielogo.API.
+func (f *Client) GetTokenPriceV1(ctx context.Context, req *apiv1.TokenPriceRequest) (*apiv1.TokenPriceResponse, error) {
+	return invoke[*apiv1.TokenPriceResponse](ctx, f, cielogo.EndpointGetTokenPriceV1, req)
+}
+
+// GetTokenStatsV1 implements cielogo.API.
+func (f *Client) GetTokenStatsV1(ctx context.Context, req *apiv1.TokenStatsRequest) (*apiv1.TokenStatsResponse, error) {
+	return invoke[*apiv1.TokenStatsResponse](ctx, f, cielogo.EndpointGetTokenStatsV1, req)
+}
+
+// GetTokenBalanceV1 implements cielogo.API.
+func (f *Client) GetTokenBalanceV1(ctx context.Context, req *apiv1.TokenBalanceRequest) (*apiv1.TokenBalanceResponse, error) {
+	return invoke[*apiv1.TokenBalanceResponse](ctx, f, cielogo.EndpointGetTokenBalanceV1, req)
+}
@@ -0,0 +1,111 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api/apiv1"
+)
+
+// Websocket is an in-memory cielogo.WebsocketConn. Tests push events with Push
+// and inspect the commands sent with Commands.
+type Websocket struct {
+	mu       sync.Mutex
+	commands []apiv1.WebSocketsCommand
+	sendErr  error
+	events   chan apiv1.WSEvent
+	closed   chan struct{}
+	close    sync.Once
+}
+
+var _ cielogo.WebsocketConn = (*Websocket)(nil)
+
+// NewWebsocket returns an open fake connection.
+func NewWebsocket() *Websocket {
+	return &Websocket{
+		events: make(chan apiv1.WSEvent),
+		closed: make(chan struct{}),
+	}
+}
+
+// SendCommand records cmd.
+func (w *Websocket) SendCommand(cmd apiv1.WebSocketsCommand) error {
+	return w.SendCommandContext(context.Background(), cmd)
+}
+
+// SendCommandContext records cmd, or returns the error set with SetSendError.
+func (w *Websocket) SendCommandContext(_ context.Context, cmd apiv1.WebSocketsCommand) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	select {
+	case <-w.closed:
+		return fmt.Errorf("fake: cannot send %s: connection closed", cmd.GetType())
+	default:
+	}
+
+	if w.sendErr != nil {
+		return w.sendErr
+	}
+
+	w.commands = append(w.commands, cmd)
+
+	return nil
+}
+
+// RunListener forwards the pushed events to out until the context is done or the connection is closed.
+func (w *Websocket) RunListener(ctx context.Context, out chan<- apiv1.WSEvent) error {
+	for {
+		select {
+		case event := <-w.events:
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return nil
+			}
+		case <-w.closed:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Close closes the connection, stopping RunListener.
+func (w *Websocket) Close() {
+	w.close.Do(func() { close(w.closed) })
+}
+
+// Push delivers events to the running listener, blocking until each one is received
+// or the context is done.
+func (w *Websocket) Push(ctx context.Context, events ...apiv1.WSEvent) error {
+	for _, event := range events {
+		select {
+		case w.events <- event:
+		case <-w.closed:
+			return fmt.Errorf("fake: cannot push %s event: connection closed", event.Type)
+		case <-ctx.Done():
+			return fmt.Errorf("fake: cannot push %s event: %w", event.Type, ctx.Err())
+		}
+	}
+
+	return nil
+}
+
+// Commands returns the commands sent, in order.
+func (w *Websocket) Commands() []apiv1.WebSocketsCommand {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return append([]apiv1.WebSocketsCommand(nil), w.commands...)
+}
+
+// SetSendError makes SendCommand fail with err. A nil err removes the injected error.
+func (w *Websocket) SetSendError(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.sendErr = err
+}
@@ -0,0 +1,91 @@
+package cielogo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileCache is a Cache that stores every entry as a JSON file in a directory,
+// so cached responses survive restarts and can be shared between processes.
+// Errors while reading or writing files are treated as cache misses.
+type FileCache struct {
+	dir string
+}
+
+type fileCacheEntry struct {
+	Key       string          `json:"key"`
+	ExpiresAt time.Time       `json:"expires_at"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// NewFileCache creates a file backed cache in dir, creating the directory if needed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	return &FileCache{dir: dir}, nil
+}
+
+// Get returns the value stored for key, if present and not expired.
+func (c *FileCache) Get(key string) ([]byte, bool) {
+	path := c.path(key)
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var entry fileCacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil || entry.Key != key {
+		return nil, false
+	}
+
+	if time.Now().After(entry.ExpiresAt) {
+		_ = os.Remove(path)
+		return nil, false
+	}
+
+	return entry.Value, true
+}
+
+// Set stores value for key for the given time to live.
+// The value must be valid JSON, which holds for API response bodies.
+func (c *FileCache) Set(key string, value []byte, ttl time.Duration) {
+	raw, err := json.Marshal(fileCacheEntry{
+		Key:       key,
+		ExpiresAt: time.Now().Add(ttl),
+		Value:     value,
+	})
+	if err != nil {
+		return
+	}
+
+	// Write to a temporary file first so readers never see a partially written entry.
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return
+	}
+
+	if err := tmp.Close(); err != nil {
+		return
+	}
+
+	_ = os.Rename(tmp.Name(), c.path(key))
+}
+
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -1,15 +1,18 @@
-//go:build integration
-// +build integration
-
 package cielogo_test
 
 import (
 	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/sealtv/cielogo"
 	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -24,19 +27,53 @@ const (
 	testEthereumToken = "0xdAC17F958D2ee523a2206206994597C13D831ec7"   // USDT on Ethereum
 )
 
+// getTestClient returns a client replaying the test's cassette from testdata/cassettes; the test
+// fails if the cassette is missing. With CIELO_RECORD and CIELO_API_KEY set, the client calls the
+// live API instead and records the cassette again, with the API key scrubbed.
 func getTestClient(t *testing.T) *cielogo.Client {
 	t.Helper()
 
-	if testing.Short() {
-		t.Skip("Skipping integration test in short mode")
+	cassette := cassettePath(t.Name())
+	if os.Getenv("CIELO_RECORD") != "" {
+		apiKey := os.Getenv("CIELO_API_KEY")
+		if apiKey == "" {
+			t.Fatal("CIELO_API_KEY is required to record cassettes")
+		}
+
+		rec := testutil.NewRecorder(t, cassette, testutil.ModeRecord)
+		return cielogo.NewClient(apiKey, cielogo.WithHTTPClient(rec.Client()))
 	}
 
-	apiKey := os.Getenv("CIELO_API_KEY")
-	if apiKey == "" {
-		t.Skip("CIELO_API_KEY not set, skipping integration test")
+	rec := testutil.NewRecorder(t, cassette, testutil.ModeReplay)
+	return cielogo.NewClient("replay-key", cielogo.WithHTTPClient(rec.Client()))
+}
+
+// cassettePath returns the cassette file of the test.
+func cassettePath(test string) string {
+	return filepath.Join("testdata", "cassettes", strings.ReplaceAll(test, "/", "_")+".json")
+}
+
+// TestIntegration_CassettesCommitted fails for every integration test without a cassette, so that
+// a scenario added without recording it does not pass unnoticed.
+func TestIntegration_CassettesCommitted(t *testing.T) {
+	if os.Getenv("CIELO_RECORD") != "" {
+		t.Skip("cassettes are being recorded")
 	}
 
-	return cielogo.NewClient(apiKey)
+	file, err := parser.ParseFile(token.NewFileSet(), "integration_test.go", nil, 0)
+	require.NoError(t, err)
+
+	var tests int
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !strings.HasPrefix(fn.Name.Name, "TestIntegration_") || fn.Name.Name == t.Name() {
+			continue
+		}
+
+		tests++
+		assert.FileExists(t, cassettePath(fn.Name.Name), "record it with make record-integration")
+	}
+	assert.Positive(t, tests)
 }
 
 func TestIntegration_GetWalletPortfolioV1(t *testing.T) {
@@ -0,0 +1,118 @@
+package cielogo
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// LogLevels configures the levels of the messages logged by the client.
+type LogLevels struct {
+	// Success is the level of successful requests, cache hits and polls of data not ready yet.
+	Success slog.Level
+	// Retry is the level of failed attempts that are retried.
+	Retry slog.Level
+	// Failure is the level of failed requests.
+	Failure slog.Level
+	// Websocket is the level of WebSocket lifecycle events (dial, commands, acks, close).
+	Websocket slog.Level
+}
+
+// DefaultLogLevels returns the levels used unless WithLogLevels is set.
+func DefaultLogLevels() LogLevels {
+	return LogLevels{
+		Success:   slog.LevelDebug,
+		Retry:     slog.LevelWarn,
+		Failure:   slog.LevelError,
+		Websocket: slog.LevelInfo,
+	}
+}
+
+// WithLogger enables logging of every API call and WebSocket lifecycle event.
+// The API key is never logged.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger.logger = logger
+	}
+}
+
+// WithLogLevels sets the levels used by the logger configured with WithLogger
+func WithLogLevels(levels LogLevels) ClientOption {
+	return func(c *Client) {
+		c.logger.levels = levels
+	}
+}
+
+// clientLogger logs client activity, redacting the API key from every message.
+type clientLogger struct {
+	logger *slog.Logger
+	levels LogLevels
+	apiKey string
+}
+
+func (l *clientLogger) enabled(ctx context.Context, level slog.Level) bool {
+	return l.logger != nil && l.logger.Enabled(ctx, level)
+}
+
+func (l *clientLogger) log(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	if !l.enabled(ctx, level) {
+		return
+	}
+
+	l.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// logAttempt logs the outcome of a single HTTP attempt. Data that is not ready yet is logged at
+// the Success level.
+func (l *clientLogger) logAttempt(ctx context.Context, call *Call, attempt, status int, latency time.Duration, err error, retrying bool) {
+	level := l.levels.Success
+	switch {
+	case isNotReady(err):
+	case err != nil && retrying:
+		level = l.levels.Retry
+	case err != nil:
+		level = l.levels.Failure
+	}
+
+	if !l.enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("endpoint", call.Endpoint),
+		slog.String("method", call.Method),
+		slog.String("path", l.redact(call.Path)),
+		slog.Int("status", status),
+		slog.Duration("latency", latency),
+		slog.Int("attempt", attempt),
+		slog.Int("credits", call.Credits),
+	}
+	switch {
+	case isNotReady(err):
+		attrs = append(attrs, slog.Bool("data_not_ready", true))
+	case err != nil:
+		attrs = append(attrs, slog.String("error", l.redact(err.Error())), slog.Bool("retrying", retrying))
+	}
+
+	l.log(ctx, level, "cielo api request", attrs...)
+}
+
+// logCacheHit logs a call served from the response cache.
+func (l *clientLogger) logCacheHit(ctx context.Context, call *Call) {
+	l.log(ctx, l.levels.Success, "cielo api cache hit",
+		slog.String("endpoint", call.Endpoint),
+		slog.String("path", l.redact(call.Path)),
+	)
+}
+
+// redact removes the API key from s.
+func (l *clientLogger) redact(s string) string {
+	if l.apiKey == "" {
+		return s
+	}
+
+	return strings.ReplaceAll(s, l.apiKey, redacted)
+}
@@ -0,0 +1,230 @@
+package cielogo_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const secretKey = "super-secret-key"
+
+// logBuffer collects JSON log records.
+type logBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *logBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.String()
+}
+
+func (b *logBuffer) records(t *testing.T) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(b.String()), "\n") {
+		if line == "" {
+			continue
+		}
+
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func newTestLogger(level slog.Level) (*slog.Logger, *logBuffer) {
+	buf := &logBuffer{}
+
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: level})), buf
+}
+
+func TestLogging_RequestAttributes(t *testing.T) {
+	server := newCachedServer(t)
+	logger, buf := newTestLogger(slog.LevelDebug)
+	client := cielogo.NewClient(secretKey, cielogo.WithBaseURL(server.URL), cielogo.WithLogger(logger))
+
+	_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.NoError(t, err)
+
+	records := buf.records(t)
+	require.Len(t, records, 1)
+	record := records[0]
+	assert.Equal(t, "DEBUG", record["level"])
+	assert.Equal(t, "cielo api request", record["msg"])
+	assert.Equal(t, cielogo.EndpointGetTokenPriceV1, record["endpoint"])
+	assert.Equal(t, "GET", record["method"])
+	assert.Equal(t, priceQueryPath, record["path"])
+	assert.InDelta(t, 200, record["status"], 0)
+	assert.InDelta(t, 1, record["attempt"], 0)
+	assert.InDelta(t, 1, record["credits"], 0)
+	assert.Contains(t, record, "latency")
+	assert.NotContains(t, buf.String(), secretKey)
+}
+
+func TestLogging_RetriesAndFailures(t *testing.T) {
+	server := newFlakyServer(t, priceQueryPath, 10, http.StatusServiceUnavailable, nil)
+	logger, buf := newTestLogger(slog.LevelDebug)
+	client := cielogo.NewClient(secretKey,
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRetryPolicy(fastRetryPolicy()),
+		cielogo.WithLogger(logger),
+	)
+
+	_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.Error(t, err)
+
+	records := buf.records(t)
+	require.Len(t, records, 3)
+	for i, record := range records[:2] {
+		assert.Equal(t, "WARN", record["level"])
+		assert.InDelta(t, i+1, record["attempt"], 0)
+		assert.Equal(t, true, record["retrying"])
+	}
+	assert.Equal(t, "ERROR", records[2]["level"])
+	assert.InDelta(t, 503, records[2]["status"], 0)
+	assert.Equal(t, false, records[2]["retrying"])
+	assert.Contains(t, records[2]["error"], "try again")
+}
+
+func TestLogging_Levels(t *testing.T) {
+	server := newCachedServer(t)
+	logger, buf := newTestLogger(slog.LevelInfo)
+	levels := cielogo.DefaultLogLevels()
+	levels.Success = slog.LevelInfo
+	client := cielogo.NewClient(secretKey,
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithLogger(logger),
+		cielogo.WithLogLevels(levels),
+		cielogo.WithCache(cielogo.NewLRUCache(10), time.Minute),
+	)
+
+	for range 2 {
+		_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+		require.NoError(t, err)
+	}
+
+	records := buf.records(t)
+	require.Len(t, records, 2)
+	assert.Equal(t, "INFO", records[0]["level"])
+	assert.Equal(t, "cielo api cache hit", records[1]["msg"])
+}
+
+func TestLogging_RedactsAPIKey(t *testing.T) {
+	logger, buf := newTestLogger(slog.LevelDebug)
+	client := cielogo.NewClient(secretKey,
+		cielogo.WithBaseURL("http://127.0.0.1:0/"+secretKey),
+		cielogo.WithLogger(logger),
+	)
+
+	_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.Error(t, err)
+	assert.NotContains(t, buf.String(), secretKey)
+	assert.Contains(t, buf.String(), "[REDACTED]")
+}
+
+// newScriptedWebsocketServer returns the URL of a WebSocket server that waits for a command,
+// writes messages and closes the connection normally.
+func newScriptedWebsocketServer(t *testing.T, messages ...string) string {
+	t.Helper()
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var cmd map[string]any
+		if err := conn.ReadJSON(&cmd); err != nil {
+			return
+		}
+
+		for _, message := range messages {
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(message))
+		}
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "bye"))
+	}))
+	t.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+// runScriptedListener subscribes to the feed and returns the events received until the server closes.
+func runScriptedListener(t *testing.T, client *cielogo.Client) []apiv1.WSEvent {
+	t.Helper()
+
+	ctx := context.Background()
+	ws, err := client.NewWebsocketConnection(ctx)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	require.NoError(t, ws.SendCommand(&apiv1.FeedSubscribeCmd{}))
+
+	events := make(chan apiv1.WSEvent, 10)
+	require.NoError(t, ws.RunListener(ctx, events))
+	close(events)
+
+	var received []apiv1.WSEvent
+	for event := range events {
+		received = append(received, event)
+	}
+
+	return received
+}
+
+func TestLogging_Websocket(t *testing.T) {
+	url := newScriptedWebsocketServer(t,
+		`{"type":"feed_subscribed","data":{}}`,
+		`not json`,
+		`{"type":"error","data":"bad filter"}`,
+	)
+	logger, buf := newTestLogger(slog.LevelDebug)
+	client := cielogo.NewClient(secretKey, cielogo.WithWebsocketURL(url), cielogo.WithLogger(logger))
+
+	var types []apiv1.EventType
+	for _, event := range runScriptedListener(t, client) {
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []apiv1.EventType{apiv1.FeedSubscribedEventType, apiv1.ErrEventType}, types)
+
+	var messages []string
+	for _, record := range buf.records(t) {
+		messages = append(messages, record["msg"].(string)) //nolint:errcheck // test log records always have a message
+	}
+	assert.Equal(t, []string{
+		"cielo websocket connected",
+		"cielo websocket command sent",
+		"cielo websocket subscription acknowledged",
+		"cielo websocket event decode failed",
+		"cielo websocket error event",
+		"cielo websocket closed",
+	}, messages)
+	assert.NotContains(t, buf.String(), secretKey)
+}
@@ -0,0 +1,129 @@
+package cielogo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sealtv/cielogo/api"
+)
+
+// Headers checked for the request ID, rate-limit and credit information, in order of preference.
+// The API does not document these headers; the most common names are recognized.
+var (
+	requestIDHeaders          = []string{"X-Request-Id", "X-Amzn-Requestid", "Cf-Ray"}
+	rateLimitLimitHeaders     = []string{"X-Ratelimit-Limit", "Ratelimit-Limit"}
+	rateLimitRemainingHeaders = []string{"X-Ratelimit-Remaining", "Ratelimit-Remaining"}
+	rateLimitResetHeaders     = []string{"X-Ratelimit-Reset", "Ratelimit-Reset"}
+	creditsRemainingHeaders   = []string{"X-Credits-Remaining", "X-Api-Credits-Remaining", "X-Credit-Balance"}
+)
+
+// ResponseMeta describes the response to a single API call, see CaptureResponseMeta.
+type ResponseMeta struct {
+	// StatusCode is the HTTP status of the last response, or 0 if none was received.
+	StatusCode int
+	// Header holds the headers of the last response.
+	Header http.Header
+	// Status and Message are the fields of the response envelope.
+	Status  string
+	Message string
+	// RequestID is the request identifier sent by the API or its proxy, if any.
+	RequestID string
+	// RateLimitLimit, RateLimitRemaining and RateLimitReset are parsed from the rate-limit headers, if sent.
+	RateLimitLimit     *int
+	RateLimitRemaining *int
+	RateLimitReset     *int
+	// CreditsRemaining is parsed from the remaining-credit header, if sent.
+	CreditsRemaining *int
+	// Latency is the total duration of the call, retries included.
+	Latency time.Duration
+	// Attempts is the number of HTTP requests sent; 0 when the response came from the cache.
+	Attempts int
+	// Cached reports whether the response was served from the response cache.
+	Cached bool
+}
+
+type responseMetaKey struct{}
+
+// CaptureResponseMeta returns a context that makes the client fill meta with the metadata of the
+// response to the call made with it. When the context is used for several calls, meta describes the last one.
+//
+//	var meta cielogo.ResponseMeta
+//	feed, err := client.GetFeedV1(cielogo.CaptureResponseMeta(ctx, &meta), req)
+//	log.Println(meta.StatusCode, meta.RequestID, meta.Latency)
+func CaptureResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, meta)
+}
+
+// response is the outcome of the HTTP requests sent for a call.
+// Its status and header are those of the last response received, if any.
+type response struct {
+	body       []byte
+	statusCode int
+	header     http.Header
+	attempts   int
+}
+
+// fillResponseMeta fills the ResponseMeta carried by ctx, if any.
+func fillResponseMeta(ctx context.Context, start time.Time, resp *response, cached bool, err error) {
+	meta, ok := ctx.Value(responseMetaKey{}).(*ResponseMeta)
+	if !ok || meta == nil {
+		return
+	}
+
+	*meta = ResponseMeta{
+		Latency: time.Since(start),
+		Cached:  cached,
+	}
+
+	if resp != nil {
+		meta.StatusCode = resp.statusCode
+		meta.Header = resp.header
+		meta.Attempts = resp.attempts
+	}
+
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) {
+		meta.Status = apiErr.Status
+		meta.Message = apiErr.Message
+	} else if resp != nil {
+		var envelope api.CieloResponse[json.RawMessage]
+		if json.Unmarshal(resp.body, &envelope) == nil {
+			meta.Status = envelope.Status
+			meta.Message = envelope.Message
+		}
+	}
+
+	meta.RequestID = firstHeader(meta.Header, requestIDHeaders)
+	meta.RateLimitLimit = intHeader(meta.Header, rateLimitLimitHeaders)
+	meta.RateLimitRemaining = intHeader(meta.Header, rateLimitRemainingHeaders)
+	meta.RateLimitReset = intHeader(meta.Header, rateLimitResetHeaders)
+	meta.CreditsRemaining = intHeader(meta.Header, creditsRemainingHeaders)
+}
+
+func firstHeader(header http.Header, names []string) string {
+	for _, name := range names {
+		if value := header.Get(name); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func intHeader(header http.Header, names []string) *int {
+	value := firstHeader(header, names)
+	if value == "" {
+		return nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return nil
+	}
+
+	return &n
+}
@@ -0,0 +1,83 @@
+package cielogo_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseMeta_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-123")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-Credits-Remaining", "9000")
+		_, _ = w.Write([]byte(`{"status":"ok","message":"fresh","data":{"chain":"solana","price":1.5}}`))
+	}))
+	defer server.Close()
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL))
+
+	var meta cielogo.ResponseMeta
+	resp, err := client.GetTokenPriceV1(cielogo.CaptureResponseMeta(context.Background(), &meta), priceRequest)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, resp.Price, 0.0001)
+
+	assert.Equal(t, http.StatusOK, meta.StatusCode)
+	assert.Equal(t, "ok", meta.Status)
+	assert.Equal(t, "fresh", meta.Message)
+	assert.Equal(t, "req-123", meta.RequestID)
+	assert.Equal(t, testutil.Ptr(100), meta.RateLimitLimit)
+	assert.Equal(t, testutil.Ptr(42), meta.RateLimitRemaining)
+	assert.Nil(t, meta.RateLimitReset)
+	assert.Equal(t, testutil.Ptr(9000), meta.CreditsRemaining)
+	assert.Equal(t, "application/json", meta.Header.Get("Content-Type"))
+	assert.Equal(t, 1, meta.Attempts)
+	assert.Positive(t, meta.Latency)
+	assert.False(t, meta.Cached)
+}
+
+func TestResponseMeta_ErrorAndRetries(t *testing.T) {
+	server := newFlakyServer(t, priceQueryPath, 10, http.StatusTooManyRequests, http.Header{"X-Request-Id": {"req-429"}})
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRetryPolicy(fastRetryPolicy()),
+	)
+
+	var meta cielogo.ResponseMeta
+	_, err := client.GetTokenPriceV1(cielogo.CaptureResponseMeta(context.Background(), &meta), priceRequest)
+	require.Error(t, err)
+
+	assert.Equal(t, http.StatusTooManyRequests, meta.StatusCode)
+	assert.Equal(t, "error", meta.Status)
+	assert.Equal(t, "try again", meta.Message)
+	assert.Equal(t, "req-429", meta.RequestID)
+	assert.Equal(t, 3, meta.Attempts)
+}
+
+func TestResponseMeta_Cached(t *testing.T) {
+	server := newCachedServer(t)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithCache(cielogo.NewLRUCache(10), time.Minute),
+	)
+	ctx := context.Background()
+
+	_, err := client.GetTokenPriceV1(ctx, priceRequest)
+	require.NoError(t, err)
+
+	var meta cielogo.ResponseMeta
+	_, err = client.GetTokenPriceV1(cielogo.CaptureResponseMeta(ctx, &meta), priceRequest)
+	require.NoError(t, err)
+
+	assert.True(t, meta.Cached)
+	assert.Zero(t, meta.Attempts)
+	assert.Zero(t, meta.StatusCode)
+}
@@ -0,0 +1,224 @@
+package cielogo
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// latencyBuckets are the upper bounds, in seconds, of the request latency histogram.
+var latencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Metrics collects per-endpoint request metrics and WebSocket event counters.
+// Every client keeps its own Metrics, see Client.Metrics. The client does not reconnect
+// WebSockets by itself, so every connection a client opens after its first one is counted
+// as a reconnect.
+type Metrics struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointMetrics
+	txEvents  map[string]uint64
+	dials     uint64
+}
+
+type endpointMetrics struct {
+	requests  uint64
+	errors    map[string]uint64
+	bytes     uint64
+	credits   uint64
+	cacheHits uint64
+
+	latencyCounts []uint64
+	latencySum    float64
+}
+
+func newMetrics() *Metrics {
+	return &Metrics{
+		endpoints: make(map[string]*endpointMetrics),
+		txEvents:  make(map[string]uint64),
+	}
+}
+
+// Metrics returns the metrics collected by the client.
+func (c *Client) Metrics() *Metrics {
+	return c.metrics
+}
+
+// endpoint returns the metrics of the endpoint, creating them if needed.
+// It must be called with m.mu held.
+func (m *Metrics) endpoint(name string) *endpointMetrics {
+	em, ok := m.endpoints[name]
+	if !ok {
+		em = &endpointMetrics{
+			errors:        make(map[string]uint64),
+			latencyCounts: make([]uint64, len(latencyBuckets)),
+		}
+		m.endpoints[name] = em
+	}
+
+	return em
+}
+
+// observeAttempt records a single HTTP attempt. status is 0 when no response was received.
+func (m *Metrics) observeAttempt(call *Call, status int, latency time.Duration, size int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	em := m.endpoint(call.Endpoint)
+	em.requests++
+	em.credits += uint64(max(call.Credits, 0))
+	em.bytes += uint64(size)
+
+	if err != nil && !isNotReady(err) {
+		label := "none"
+		if status != 0 {
+			label = strconv.Itoa(status)
+		}
+		em.errors[label]++
+	}
+
+	seconds := latency.Seconds()
+	em.latencySum += seconds
+	for i, bound := range latencyBuckets {
+		if seconds <= bound {
+			em.latencyCounts[i]++
+		}
+	}
+}
+
+// observeCacheHit records a call served from the response cache.
+func (m *Metrics) observeCacheHit(call *Call) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.endpoint(call.Endpoint).cacheHits++
+}
+
+// observeDial records a successful WebSocket connection.
+func (m *Metrics) observeDial() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.dials++
+}
+
+// observeTxEvent records a transaction event received over a WebSocket.
+func (m *Metrics) observeTxEvent(txType string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.txEvents[txType]++
+}
+
+// Handler returns an http.Handler serving the metrics in the Prometheus text exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_ = m.WritePrometheus(w)
+	})
+}
+
+// WritePrometheus writes the metrics to w in the Prometheus text exposition format.
+//
+// Exposed metrics:
+//   - cielo_requests_total{endpoint}: HTTP requests sent, retries included
+//   - cielo_request_errors_total{endpoint,status}: failed requests by HTTP status ("none" for transport errors);
+//     202 Accepted answers of data not ready yet are not failures
+//   - cielo_request_duration_seconds{endpoint}: request latency histogram
+//   - cielo_response_bytes_total{endpoint}: bytes of successful response bodies
+//   - cielo_credits_total{endpoint}: estimated credits consumed
+//   - cielo_cache_hits_total{endpoint}: calls served from the response cache
+//   - cielo_websocket_tx_events_total{tx_type}: transaction events received
+//   - cielo_websocket_connections_total: WebSocket connections opened
+//   - cielo_websocket_reconnects_total: WebSocket connections opened after the first one
+func (m *Metrics) WritePrometheus(w io.Writer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	bw := bufio.NewWriter(w)
+	endpoints := sortedKeys(m.endpoints)
+
+	m.writeCounter(bw, endpoints, "cielo_requests_total", "HTTP requests sent, retries included.",
+		func(em *endpointMetrics) uint64 { return em.requests })
+
+	writeHeader(bw, "cielo_request_errors_total", "counter", "Failed HTTP requests by status.")
+	for _, name := range endpoints {
+		errs := m.endpoints[name].errors
+		for _, status := range sortedKeys(errs) {
+			fmt.Fprintf(bw, "cielo_request_errors_total{endpoint=%s,status=%s} %d\n",
+				quoteLabel(name), quoteLabel(status), errs[status])
+		}
+	}
+
+	writeHeader(bw, "cielo_request_duration_seconds", "histogram", "HTTP request latency.")
+	for _, name := range endpoints {
+		em := m.endpoints[name]
+		for i, bound := range latencyBuckets {
+			fmt.Fprintf(bw, "cielo_request_duration_seconds_bucket{endpoint=%s,le=%s} %d\n",
+				quoteLabel(name), quoteLabel(formatFloat(bound)), em.latencyCounts[i])
+		}
+		fmt.Fprintf(bw, "cielo_request_duration_seconds_bucket{endpoint=%s,le=\"+Inf\"} %d\n", quoteLabel(name), em.requests)
+		fmt.Fprintf(bw, "cielo_request_duration_seconds_sum{endpoint=%s} %s\n", quoteLabel(name), formatFloat(em.latencySum))
+		fmt.Fprintf(bw, "cielo_request_duration_seconds_count{endpoint=%s} %d\n", quoteLabel(name), em.requests)
+	}
+
+	m.writeCounter(bw, endpoints, "cielo_response_bytes_total", "Bytes of successful response bodies.",
+		func(em *endpointMetrics) uint64 { return em.bytes })
+	m.writeCounter(bw, endpoints, "cielo_credits_total", "Estimated API credits consumed.",
+		func(em *endpointMetrics) uint64 { return em.credits })
+	m.writeCounter(bw, endpoints, "cielo_cache_hits_total", "Calls served from the response cache.",
+		func(em *endpointMetrics) uint64 { return em.cacheHits })
+
+	writeHeader(bw, "cielo_websocket_tx_events_total", "counter", "Transaction events received over WebSockets.")
+	for _, txType := range sortedKeys(m.txEvents) {
+		fmt.Fprintf(bw, "cielo_websocket_tx_events_total{tx_type=%s} %d\n", quoteLabel(txType), m.txEvents[txType])
+	}
+
+	writeHeader(bw, "cielo_websocket_connections_total", "counter", "WebSocket connections opened.")
+	fmt.Fprintf(bw, "cielo_websocket_connections_total %d\n", m.dials)
+
+	writeHeader(bw, "cielo_websocket_reconnects_total", "counter", "WebSocket connections opened after the first one.")
+	fmt.Fprintf(bw, "cielo_websocket_reconnects_total %d\n", max(m.dials, 1)-1)
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("failed to write metrics: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Metrics) writeCounter(w io.Writer, endpoints []string, name, help string, value func(*endpointMetrics) uint64) {
+	writeHeader(w, name, "counter", help)
+	for _, endpoint := range endpoints {
+		fmt.Fprintf(w, "%s{endpoint=%s} %d\n", name, quoteLabel(endpoint), value(m.endpoints[endpoint]))
+	}
+}
+
+func writeHeader(w io.Writer, name, kind, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func quoteLabel(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+	return `"` + replacer.Replace(value) + `"`
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,85 @@
+package cielogo_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scrapeMetrics(t *testing.T, client *cielogo.Client) string {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	client.Metrics().Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/plain; version=0.0.4")
+
+	return rec.Body.String()
+}
+
+func TestMetrics_Requests(t *testing.T) {
+	server := newCachedServer(t)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithCache(cielogo.NewLRUCache(10), time.Minute),
+	)
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := client.GetTokenPriceV1(ctx, priceRequest)
+		require.NoError(t, err)
+	}
+	_, err := client.GetTokenStatsV1(ctx, &apiv1.TokenStatsRequest{Chain: apiv1.TokenChainSolana, TokenAddress: "token"})
+	require.Error(t, err)
+
+	out := scrapeMetrics(t, client)
+	assert.Contains(t, out, "# TYPE cielo_requests_total counter\n")
+	assert.Contains(t, out, `cielo_requests_total{endpoint="GetTokenPriceV1"} 1`+"\n")
+	assert.Contains(t, out, `cielo_cache_hits_total{endpoint="GetTokenPriceV1"} 1`+"\n")
+	assert.Contains(t, out, `cielo_credits_total{endpoint="GetTokenPriceV1"} 1`+"\n")
+	assert.Contains(t, out, `cielo_response_bytes_total{endpoint="GetTokenPriceV1"} `)
+	assert.NotContains(t, out, `cielo_response_bytes_total{endpoint="GetTokenPriceV1"} 0`+"\n")
+	assert.Contains(t, out, `cielo_request_duration_seconds_bucket{endpoint="GetTokenPriceV1",le="+Inf"} 1`+"\n")
+	assert.Contains(t, out, `cielo_request_duration_seconds_count{endpoint="GetTokenPriceV1"} 1`+"\n")
+	assert.Contains(t, out, `cielo_request_errors_total{endpoint="GetTokenStatsV1",status="404"} 1`+"\n")
+}
+
+func TestMetrics_RetriesCounted(t *testing.T) {
+	server := newFlakyServer(t, priceQueryPath, 2, http.StatusServiceUnavailable, nil)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRetryPolicy(fastRetryPolicy()),
+	)
+
+	_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.NoError(t, err)
+
+	out := scrapeMetrics(t, client)
+	assert.Contains(t, out, `cielo_requests_total{endpoint="GetTokenPriceV1"} 3`+"\n")
+	assert.Contains(t, out, `cielo_request_errors_total{endpoint="GetTokenPriceV1",status="503"} 2`+"\n")
+}
+
+func TestMetrics_Websocket(t *testing.T) {
+	url := newScriptedWebsocketServer(t,
+		`{"type":"tx","data":{"tx_type":"transfer","chain":"ethereum","wallet":"0x1"}}`,
+		`{"type":"tx","data":{"tx_type":"transfer","chain":"ethereum","wallet":"0x2"}}`,
+		`{"type":"tx","data":{"tx_type":"swap","chain":"ethereum","wallet":"0x1"}}`,
+	)
+	client := cielogo.NewClient("test-key", cielogo.WithWebsocketURL(url))
+
+	runScriptedListener(t, client)
+	runScriptedListener(t, client)
+
+	out := scrapeMetrics(t, client)
+	assert.Contains(t, out, `cielo_websocket_tx_events_total{tx_type="swap"} 2`+"\n")
+	assert.Contains(t, out, `cielo_websocket_tx_events_total{tx_type="transfer"} 4`+"\n")
+	assert.Contains(t, out, "cielo_websocket_connections_total 2\n")
+	assert.Contains(t, out, "cielo_websocket_reconnects_total 1\n")
+}
@@ -0,0 +1,278 @@
+package cielogo
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/sealtv/cielogo/api/apiv1"
+)
+
+// Seq is an iterator over the items of a paginated endpoint. It fetches pages lazily and calls
+// yield for each item until yield returns false, the pages or the item limit are exhausted,
+// or a request fails. A failure, including a cancelled context, is yielded once as the final
+// error with the zero item.
+//
+// Call it with a yield function, or use Collect:
+//
+//	cielogo.FeedAll(ctx, client, &apiv1.FeedRequest{})(func(event apiv1.TxEvent, err error) bool {
+//		if err != nil {
+//			return false
+//		}
+//		...
+//		return true
+//	})
+//
+// Seq has the shape of iter.Seq2[T, error], so callers whose own module targets Go 1.23 or
+// later can also range over it; this module targets Go 1.22.
+type Seq[T any] func(yield func(T, error) bool)
+
+// Collect returns every item of the sequence, stopping at the first error.
+func (s Seq[T]) Collect() ([]T, error) {
+	var (
+		items []T
+		err   error
+	)
+	s(func(item T, e error) bool {
+		if e != nil {
+			err = e
+			return false
+		}
+
+		items = append(items, item)
+		return true
+	})
+
+	return items, err
+}
+
+// PageOption configures a pagination iterator.
+type PageOption func(*pageConfig)
+
+type pageConfig struct {
+	pageSize int
+	maxItems int
+}
+
+// WithPageSize sets the number of items requested per page, for endpoints that accept a limit
+// (the feed and wallets by tag). Other endpoints use the API's page size.
+func WithPageSize(n int) PageOption {
+	return func(c *pageConfig) {
+		c.pageSize = n
+	}
+}
+
+// WithMaxItems stops the iteration after n items. Zero means no limit.
+func WithMaxItems(n int) PageOption {
+	return func(c *pageConfig) {
+		c.maxItems = n
+	}
+}
+
+// fetchPage fetches the page at cursor (nil for the first page) and returns its items and the
+// cursor of the next page, nil when it is the last one.
+type fetchPage[T any] func(ctx context.Context, cursor *string) ([]T, *string, error)
+
+func newPageConfig(opts []PageOption) pageConfig {
+	cfg := pageConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return cfg
+}
+
+// limit returns the page size set by WithPageSize, or the request's own limit.
+func (c pageConfig) limit(limit *int) *int {
+	if c.pageSize > 0 {
+		return &c.pageSize
+	}
+
+	return limit
+}
+
+// paginate walks the pages returned by fetch, starting at the start cursor.
+func paginate[T any](ctx context.Context, cfg pageConfig, start *string, fetch fetchPage[T]) Seq[T] {
+	return func(yield func(T, error) bool) {
+		var (
+			zero  T
+			count int
+		)
+
+		cursor := start
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, next, err := fetch(ctx, cursor)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+
+				count++
+				if cfg.maxItems > 0 && count >= cfg.maxItems {
+					return
+				}
+			}
+
+			// A cursor that does not advance would fetch the same page forever.
+			if next == nil || len(items) == 0 || (cursor != nil && *next == *cursor) {
+				return
+			}
+			cursor = next
+		}
+	}
+}
+
+// nextCursor returns the cursor of the next page, nil when there is none.
+func nextCursor(hasNext bool, cursor string) *string {
+	if !hasNext || cursor == "" || cursor == "0" {
+		return nil
+	}
+
+	return &cursor
+}
+
+// FeedAll iterates over every transaction of the feed matching req.
+func FeedAll(ctx context.Context, client FeedAPI, req *apiv1.FeedRequest, opts ...PageOption) Seq[apiv1.TxEvent] {
+	page := apiv1.FeedRequest{}
+	if req != nil {
+		page = *req
+	}
+
+	cfg := newPageConfig(opts)
+	page.Limit = cfg.limit(page.Limit)
+
+	return paginate(ctx, cfg, page.StartFrom, func(ctx context.Context, cursor *string) ([]apiv1.TxEvent, *string, error) {
+		r := page
+		r.StartFrom = cursor
+
+		resp, err := client.GetFeedV1(ctx, &r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return resp.Items, nextCursor(resp.Paging.HasNextPage, resp.Paging.NextObject), nil
+	})
+}
+
+// TokensPnlAll iterates over every token PnL entry of the wallet in req.
+func TokensPnlAll(ctx context.Context, client PnLAPI, req *apiv1.TokensPnLRequest, opts ...PageOption) Seq[apiv1.TokenPnl] {
+	page := apiv1.TokensPnLRequest{}
+	if req != nil {
+		page = *req
+	}
+
+	cfg := newPageConfig(opts)
+
+	return paginate(ctx, cfg, page.NextObject, func(ctx context.Context, cursor *string) ([]apiv1.TokenPnl, *string, error) {
+		r := page
+		r.NextObject = cursor
+
+		resp, err := client.GetTokensPnlV1(ctx, &r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return resp.Items, nextCursor(resp.Paging.HasNextPage, resp.Paging.NextObject), nil
+	})
+}
+
+// NftsPnlAll iterates over every NFT PnL entry of the wallet in req.
+func NftsPnlAll(ctx context.Context, client PnLAPI, req *apiv1.NftsPnLRequest, opts ...PageOption) Seq[apiv1.NftPnl] {
+	page := apiv1.NftsPnLRequest{}
+	if req != nil {
+		page = *req
+	}
+
+	cfg := newPageConfig(opts)
+
+	return paginate(ctx, cfg, page.NextObject, func(ctx context.Context, cursor *string) ([]apiv1.NftPnl, *string, error) {
+		r := page
+		r.NextObject = cursor
+
+		resp, err := client.GetNftsPnlV1(ctx, &r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return resp.Items, nextCursor(resp.Paging.HasNextPage, resp.Paging.NextObject), nil
+	})
+}
+
+// TrackedWalletsAll iterates over every tracked wallet, optionally of the list in req.
+func TrackedWalletsAll(ctx context.Context, client TrackedWalletsAPI, req *apiv1.GetTrackedWalletsRequest, opts ...PageOption) Seq[apiv1.TrackedWallet] {
+	page := apiv1.GetTrackedWalletsRequest{}
+	if req != nil {
+		page = *req
+	}
+
+	cfg := newPageConfig(opts)
+
+	return paginate(ctx, cfg, page.NextObject, func(ctx context.Context, cursor *string) ([]apiv1.TrackedWallet, *string, error) {
+		r := page
+		r.NextObject = cursor
+
+		resp, err := client.GetTrackedWalletsV1(ctx, &r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		next := strconv.Itoa(resp.Pagination.NextObject)
+		return resp.TrackedWallets, nextCursor(resp.Pagination.HasNextPage, next), nil
+	})
+}
+
+// WalletsByTagAll iterates over every wallet having the tags in req.
+func WalletsByTagAll(ctx context.Context, client WalletsAPI, req *apiv1.GetWalletsByTagRequest, opts ...PageOption) Seq[apiv1.Wallet] {
+	page := apiv1.GetWalletsByTagRequest{}
+	if req != nil {
+		page = *req
+	}
+
+	cfg := newPageConfig(opts)
+	page.Limit = cfg.limit(page.Limit)
+
+	return paginate(ctx, cfg, page.NextObject, func(ctx context.Context, cursor *string) ([]apiv1.Wallet, *string, error) {
+		r := page
+		r.NextObject = cursor
+
+		resp, err := client.GetWalletsByTagV1(ctx, &r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		next := strconv.Itoa(resp.Paging.NextPage)
+		return resp.Wallets, nextCursor(resp.Paging.HasNextPage, next), nil
+	})
+}
+
+// AllWalletsLists iterates over every public wallet list matching req.
+func AllWalletsLists(ctx context.Context, client ListsAPI, req *apiv1.GetAllWalletsListsRequest, opts ...PageOption) Seq[apiv1.WalletList] {
+	page := apiv1.GetAllWalletsListsRequest{}
+	if req != nil {
+		page = *req
+	}
+
+	cfg := newPageConfig(opts)
+
+	return paginate(ctx, cfg, page.NextObject, func(ctx context.Context, cursor *string) ([]apiv1.WalletList, *string, error) {
+		r := page
+		r.NextObject = cursor
+
+		resp, err := client.GetAllWalletsListV1(ctx, &r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return resp.List, nextCursor(resp.Paging.HasNextPage, resp.Paging.NextObject), nil
+	})
+}
@@ -0,0 +1,204 @@
+package cielogo_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/fake"
+	"github.com/sealtv/cielogo/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPagedClient(t *testing.T) (*testutil.FakeServer, *cielogo.Client, *int) {
+	t.Helper()
+
+	server := testutil.NewFakeServer(t)
+	requests := 0
+	counter := func(ctx context.Context, call *cielogo.Call, next cielogo.Invoker) error {
+		requests++
+		return next(ctx, call)
+	}
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL), cielogo.WithInterceptors(counter))
+
+	return server, client, &requests
+}
+
+func TestFeedAll(t *testing.T) {
+	server, client, requests := newPagedClient(t)
+	for i := range 45 {
+		server.AddFeedEvents(apiv1.TxEvent{Wallet: "0x1", TxType: apiv1.TxTypeSwap, TxHash: fmt.Sprintf("0x%d", i), Timestamp: int64(1700000000 + i)})
+	}
+
+	events, err := cielogo.FeedAll(context.Background(), client, &apiv1.FeedRequest{}, cielogo.WithPageSize(20)).Collect()
+	require.NoError(t, err)
+	require.Len(t, events, 45)
+	assert.Equal(t, 3, *requests)
+	assert.Equal(t, "0x44", events[0].TxHash)
+	assert.Equal(t, "0x0", events[44].TxHash)
+
+	*requests = 0
+	events, err = cielogo.FeedAll(context.Background(), client, nil, cielogo.WithPageSize(10), cielogo.WithMaxItems(15)).Collect()
+	require.NoError(t, err)
+	assert.Len(t, events, 15)
+	assert.Equal(t, 2, *requests)
+}
+
+func TestFeedAll_StopsWhenYieldReturnsFalse(t *testing.T) {
+	server, client, requests := newPagedClient(t)
+	for i := range 30 {
+		server.AddFeedEvents(apiv1.TxEvent{Wallet: "0x1", TxType: apiv1.TxTypeSwap, Timestamp: int64(i + 1)})
+	}
+
+	seen := 0
+	cielogo.FeedAll(context.Background(), client, &apiv1.FeedRequest{}, cielogo.WithPageSize(10))(func(_ apiv1.TxEvent, err error) bool {
+		require.NoError(t, err)
+		seen++
+		return seen < 5
+	})
+
+	assert.Equal(t, 5, seen)
+	assert.Equal(t, 1, *requests)
+}
+
+func TestTrackedWalletsAll(t *testing.T) {
+	server, client, _ := newPagedClient(t)
+	ctx := context.Background()
+
+	list, err := client.AddWalletsListV1(ctx, &apiv1.AddWalletsListRequest{Name: "whales"})
+	require.NoError(t, err)
+
+	for i := range 25 {
+		req := &apiv1.AddTrackedWalletRequest{Wallet: fmt.Sprintf("0x%02d", i), Label: "wallet"}
+		if i%5 == 0 {
+			req.ListID = &list.ID
+		}
+		_, err := client.AddTrackedWalletsV1(ctx, req)
+		require.NoError(t, err)
+	}
+	require.Len(t, server.TrackedWallets(), 25)
+
+	wallets, err := cielogo.TrackedWalletsAll(ctx, client, nil).Collect()
+	require.NoError(t, err)
+	require.Len(t, wallets, 25)
+	assert.Equal(t, "0x24", wallets[24].Wallet)
+
+	wallets, err = cielogo.TrackedWalletsAll(ctx, client, &apiv1.GetTrackedWalletsRequest{ListID: &list.ID}).Collect()
+	require.NoError(t, err)
+	assert.Len(t, wallets, 5)
+}
+
+func TestWalletsByTagAll(t *testing.T) {
+	server, client, requests := newPagedClient(t)
+	for i := range 12 {
+		server.SetWalletTags(fmt.Sprintf("0x%02d", i), apiv1.Tag{Key: apiv1.TagTypeMev})
+	}
+	server.SetWalletTags("0xother", apiv1.Tag{Key: apiv1.TagTypeFlipper})
+
+	wallets, err := cielogo.WalletsByTagAll(context.Background(), client,
+		&apiv1.GetWalletsByTagRequest{Tags: []apiv1.TagType{apiv1.TagTypeMev}}, cielogo.WithPageSize(5)).Collect()
+	require.NoError(t, err)
+	require.Len(t, wallets, 12)
+	assert.Equal(t, "0x11", wallets[11].Wallet)
+	assert.Equal(t, 3, *requests)
+}
+
+func TestAllWalletsLists(t *testing.T) {
+	server, client, requests := newPagedClient(t)
+	server.PageSize = 4
+	for i := range 10 {
+		server.AddPublicList(apiv1.WalletList{Name: fmt.Sprintf("list %d", i)})
+	}
+
+	lists, err := cielogo.AllWalletsLists(context.Background(), client, &apiv1.GetAllWalletsListsRequest{}).Collect()
+	require.NoError(t, err)
+	assert.Len(t, lists, 10)
+	assert.Equal(t, 3, *requests)
+}
+
+func TestTokensPnlAll(t *testing.T) {
+	client := fake.New()
+	client.SetHandler(cielogo.EndpointGetTokensPnlV1, func(_ context.Context, call fake.Call) (any, error) {
+		req := call.Args[0].(*apiv1.TokensPnLRequest) //nolint:errcheck // the handler is registered for this endpoint
+		if req.NextObject == nil {
+			return &apiv1.TokensPnLResponse{
+				Items:  []apiv1.TokenPnl{{Symbol: "A"}, {Symbol: "B"}},
+				Paging: apiv1.Pagination{HasNextPage: true, NextObject: "page-2"},
+			}, nil
+		}
+
+		assert.Equal(t, "page-2", *req.NextObject)
+		return &apiv1.TokensPnLResponse{Items: []apiv1.TokenPnl{{Symbol: "C"}}}, nil
+	})
+
+	req := &apiv1.TokensPnLRequest{Wallet: "0x1"}
+	tokens, err := cielogo.TokensPnlAll(context.Background(), client, req).Collect()
+	require.NoError(t, err)
+	require.Len(t, tokens, 3)
+	assert.Equal(t, "C", tokens[2].Symbol)
+	assert.Nil(t, req.NextObject, "the caller's request is not modified")
+	assert.Len(t, client.CallsTo(cielogo.EndpointGetTokensPnlV1), 2)
+}
+
+func TestPagination_Errors(t *testing.T) {
+	t.Run("request error", func(t *testing.T) {
+		client := fake.New()
+		client.SetError(cielogo.EndpointGetNftsPnlV1, errors.New("boom"))
+
+		var errs []error
+		cielogo.NftsPnlAll(context.Background(), client, &apiv1.NftsPnLRequest{Wallet: "0x1"})(func(_ apiv1.NftPnl, err error) bool {
+			errs = append(errs, err)
+			return true
+		})
+
+		require.Len(t, errs, 1)
+		assert.EqualError(t, errs[0], "boom")
+	})
+
+	t.Run("context cancelled between pages", func(t *testing.T) {
+		client := fake.New()
+		client.SetResponse(cielogo.EndpointGetFeedV1, &apiv1.FeedResponse{
+			Items:  []apiv1.TxEvent{{TxHash: "0x1"}},
+			Paging: apiv1.Pagination{HasNextPage: true, NextObject: "next"},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var (
+			events []apiv1.TxEvent
+			errs   []error
+		)
+		cielogo.FeedAll(ctx, client, &apiv1.FeedRequest{})(func(event apiv1.TxEvent, err error) bool {
+			if err != nil {
+				errs = append(errs, err)
+				return true
+			}
+
+			events = append(events, event)
+			cancel()
+			return true
+		})
+
+		assert.Len(t, events, 1)
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], context.Canceled)
+	})
+
+	t.Run("cursor does not advance", func(t *testing.T) {
+		client := fake.New()
+		client.SetResponse(cielogo.EndpointGetFeedV1, &apiv1.FeedResponse{
+			Items:  []apiv1.TxEvent{{TxHash: "0x1"}},
+			Paging: apiv1.Pagination{HasNextPage: true, NextObject: "same"},
+		})
+
+		events, err := cielogo.FeedAll(context.Background(), client, &apiv1.FeedRequest{}).Collect()
+		require.NoError(t, err)
+		assert.Len(t, events, 2)
+		assert.Len(t, client.CallsTo(cielogo.EndpointGetFeedV1), 2)
+	})
+}
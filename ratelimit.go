@@ -0,0 +1,183 @@
+package cielogo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimit configures a token bucket that allows Rate requests per second
+// with bursts of up to Burst requests.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// WithRateLimit limits the rate of all requests made by the client.
+// The limiter is shared by every goroutine using the client; requests block until
+// a token is available or their context is done.
+func WithRateLimit(limit RateLimit) ClientOption {
+	return func(c *Client) {
+		c.limiter.global = newTokenBucket(limit)
+	}
+}
+
+// WithEndpointRateLimit limits the rate of requests to a single endpoint, see the Endpoint constants.
+// It applies in addition to the global limit set by WithRateLimit.
+func WithEndpointRateLimit(endpoint string, limit RateLimit) ClientOption {
+	return func(c *Client) {
+		c.limiter.endpoints[endpoint] = newTokenBucket(limit)
+	}
+}
+
+// RateLimiterStats contains statistics about time spent waiting for the client-side rate limiter.
+type RateLimiterStats struct {
+	// Requests is the number of requests that went through the limiter.
+	Requests int64
+	// Waits is the number of requests that had to wait for a token.
+	Waits int64
+	// TotalWait is the cumulative time spent waiting.
+	TotalWait time.Duration
+	// MaxWait is the longest single wait.
+	MaxWait time.Duration
+}
+
+// RateLimiterStats returns statistics about waits caused by the client-side rate limiter.
+func (c *Client) RateLimiterStats() RateLimiterStats {
+	return c.limiter.snapshot()
+}
+
+// rateLimiter combines the global and per-endpoint token buckets of a client.
+type rateLimiter struct {
+	global    *tokenBucket
+	endpoints map[string]*tokenBucket
+
+	mu    sync.Mutex
+	stats RateLimiterStats
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		endpoints: make(map[string]*tokenBucket),
+	}
+}
+
+// buckets returns the global and the endpoint buckets that apply to the endpoint.
+func (l *rateLimiter) buckets(endpoint string) []*tokenBucket {
+	buckets := make([]*tokenBucket, 0, 2)
+	if l.global != nil {
+		buckets = append(buckets, l.global)
+	}
+	if b, ok := l.endpoints[endpoint]; ok {
+		buckets = append(buckets, b)
+	}
+
+	return buckets
+}
+
+// wait blocks until both the global and the endpoint buckets grant a token.
+func (l *rateLimiter) wait(ctx context.Context, endpoint string) error {
+	buckets := l.buckets(endpoint)
+	if len(buckets) == 0 {
+		return nil
+	}
+
+	var delay time.Duration
+	for _, b := range buckets {
+		delay = max(delay, b.reserve())
+	}
+
+	l.record(delay)
+
+	if delay <= 0 {
+		return nil
+	}
+
+	if err := sleepContext(ctx, delay); err != nil {
+		l.release(endpoint)
+
+		return fmt.Errorf("rate limiter wait: %w", err)
+	}
+
+	return nil
+}
+
+// release returns the tokens granted by wait for a request that was not sent.
+func (l *rateLimiter) release(endpoint string) {
+	for _, b := range l.buckets(endpoint) {
+		b.release()
+	}
+}
+
+func (l *rateLimiter) record(delay time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.stats.Requests++
+	if delay > 0 {
+		l.stats.Waits++
+		l.stats.TotalWait += delay
+		l.stats.MaxWait = max(l.stats.MaxWait, delay)
+	}
+}
+
+func (l *rateLimiter) snapshot() RateLimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.stats
+}
+
+// tokenBucket is a token bucket whose balance may go negative: every reservation takes
+// a token immediately and waits until the balance would have been refilled, which keeps
+// concurrent callers in FIFO order.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	burst := float64(max(limit.Burst, 1))
+
+	return &tokenBucket{
+		rate:   limit.Rate,
+		burst:  burst,
+		tokens: burst,
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait before using it.
+// The time is read under the lock so that concurrent reservations never move b.last backwards.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.rate <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	if !b.last.IsZero() {
+		b.tokens = min(b.tokens+now.Sub(b.last).Seconds()*b.rate, b.burst)
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// release returns a token taken by a reservation that was not used.
+func (b *tokenBucket) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = min(b.tokens+1, b.burst)
+}
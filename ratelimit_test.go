@@ -0,0 +1,117 @@
+package cielogo_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const priceQueryPath = "/v1/token/price?chain=solana&token_address=token"
+
+var priceRequest = &apiv1.TokenPriceRequest{Chain: apiv1.TokenChainSolana, TokenAddress: "token"}
+
+func TestRateLimit_SharedAcrossGoroutines(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	server.SetResponse(priceQueryPath, api.CieloResponse[apiv1.TokenPriceResponse]{})
+
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRateLimit(cielogo.RateLimit{Rate: 20, Burst: 1}),
+	)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// 1 request from the burst plus 4 more at 20 per second.
+	assert.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+
+	stats := client.RateLimiterStats()
+	assert.Equal(t, int64(5), stats.Requests)
+	assert.Equal(t, int64(4), stats.Waits)
+	assert.Greater(t, stats.MaxWait, 150*time.Millisecond)
+	assert.Greater(t, stats.TotalWait, stats.MaxWait)
+}
+
+func TestRateLimit_PerEndpoint(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	server.SetResponse(priceQueryPath, api.CieloResponse[apiv1.TokenPriceResponse]{})
+	server.SetResponse("/v1/wallet123/portfolio", api.CieloResponse[apiv1.WalletPortfolioResponse]{})
+
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithEndpointRateLimit(cielogo.EndpointGetWalletPortfolioV1, cielogo.RateLimit{Rate: 0.1, Burst: 1}),
+	)
+	ctx := context.Background()
+
+	_, err := client.GetWalletPortfolioV1(ctx, "wallet123")
+	require.NoError(t, err)
+
+	// Other endpoints are not affected by the portfolio limit.
+	for range 5 {
+		_, err = client.GetTokenPriceV1(ctx, priceRequest)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int64(0), client.RateLimiterStats().Waits)
+}
+
+func TestRateLimit_ContextCanceledWhileWaiting(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	server.SetResponse(priceQueryPath, api.CieloResponse[apiv1.TokenPriceResponse]{})
+
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRateLimit(cielogo.RateLimit{Rate: 0.1, Burst: 1}),
+	)
+
+	_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = client.GetTokenPriceV1(ctx, priceRequest)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	server.AssertRequestCount(t, priceQueryPath, 1)
+}
+
+func TestRateLimit_BudgetCheckedBeforeTakingToken(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	server.SetResponse(priceQueryPath, api.CieloResponse[apiv1.TokenPriceResponse]{})
+
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRateLimit(cielogo.RateLimit{Rate: 0.1, Burst: 1}),
+		cielogo.WithCreditBudget(cielogo.CreditBudget{Total: 1}),
+	)
+
+	_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.NoError(t, err)
+
+	for range 3 {
+		_, err = client.GetTokenPriceV1(context.Background(), priceRequest)
+		var budgetErr *cielogo.BudgetExceededError
+		require.ErrorAs(t, err, &budgetErr)
+	}
+
+	stats := client.RateLimiterStats()
+	assert.Equal(t, int64(1), stats.Requests, "rejected calls must not take a token")
+	assert.Zero(t, stats.Waits)
+}
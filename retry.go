@@ -0,0 +1,180 @@
+package cielogo
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how the client retries failed requests.
+//
+// Safe (GET) requests are retried by default. Mutations (POST, PUT, DELETE) are
+// not idempotent in general and are only retried when RetryMutations is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 1 are treated as 1 (no retries).
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the computed delay between two attempts. A delay requested by the API
+	// via Retry-After is honored in full, up to MaxRetryAfter.
+	MaxBackoff time.Duration
+	// MaxRetryAfter is the longest Retry-After delay the client waits for. When the API asks
+	// for a longer one, the request is not retried and fails with the 429 or 5xx error.
+	// Defaults to one minute when zero.
+	MaxRetryAfter time.Duration
+	// Multiplier is the factor applied to the backoff after each attempt. Defaults to 2.
+	Multiplier float64
+	// Jitter is the fraction (0..1) of each delay that is randomized to avoid synchronized retries.
+	Jitter float64
+	// RetryMutations enables retries for POST, PUT and DELETE requests.
+	RetryMutations bool
+	// RetryableStatusCodes overrides the HTTP statuses that trigger a retry.
+	// Defaults to 429 and all 5xx statuses when empty.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy returns a policy with 3 attempts and exponential backoff starting at 500ms.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		MaxRetryAfter:  time.Minute,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// WithRetryPolicy enables automatic retries of failed requests
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// allows reports whether requests with the given method may be retried.
+func (p *RetryPolicy) allows(method string) bool {
+	if p.MaxAttempts <= 1 {
+		return false
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return p.RetryMutations
+	}
+}
+
+// retryableStatus reports whether a response with the given status should be retried.
+func (p *RetryPolicy) retryableStatus(code int) bool {
+	if len(p.RetryableStatusCodes) > 0 {
+		return slices.Contains(p.RetryableStatusCodes, code)
+	}
+
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// shouldRetry decides whether an attempt that produced resp and err should be retried.
+// It returns the delay to wait before the next attempt.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+
+	switch {
+	case resp != nil:
+		if !p.retryableStatus(resp.StatusCode) {
+			return 0, false
+		}
+	case err == nil, errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return 0, false
+	}
+
+	delay := p.backoff(attempt)
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			if retryAfter > p.maxRetryAfter() {
+				return 0, false
+			}
+			delay = retryAfter
+		}
+	}
+
+	// Do not start a wait that the caller's deadline would cut short anyway.
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return 0, false
+	}
+
+	return delay, true
+}
+
+// maxRetryAfter returns MaxRetryAfter or its default.
+func (p *RetryPolicy) maxRetryAfter() time.Duration {
+	if p.MaxRetryAfter <= 0 {
+		return time.Minute
+	}
+
+	return p.MaxRetryAfter
+}
+
+// backoff returns the jittered exponential delay after the given attempt (starting at 1),
+// capped by MaxBackoff.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.Jitter > 0 {
+		jitter := min(p.Jitter, 1)
+		delay += delay * jitter * (2*rand.Float64() - 1) //nolint:gosec // jitter does not need a cryptographic source
+	}
+
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	return time.Duration(delay)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,166 @@
+package cielogo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const trackedWalletsPath = "/v1/tracked-wallets"
+
+// newFlakyServer returns a server that fails the first `failures` requests to path with the given status.
+func newFlakyServer(t *testing.T, path string, failures int, status int, header http.Header) *testutil.MockServer {
+	t.Helper()
+
+	server := testutil.NewMockServer(t)
+	for range failures {
+		server.QueueReplies(path, testutil.MockReply{
+			Status: status,
+			Header: header,
+			Body:   `{"status":"error","message":"try again"}`,
+		})
+	}
+	server.SetResponse(path, api.CieloResponse[apiv1.TokenPriceResponse]{
+		Status: "ok",
+		Data:   apiv1.TokenPriceResponse{Chain: "solana", Price: 1.5},
+	})
+
+	return server
+}
+
+func fastRetryPolicy() cielogo.RetryPolicy {
+	return cielogo.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+	}
+}
+
+func TestRetry_GetRetriedOnServerError(t *testing.T) {
+	server := newFlakyServer(t, priceQueryPath, 2, http.StatusServiceUnavailable, nil)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRetryPolicy(fastRetryPolicy()),
+	)
+
+	resp, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, resp.Price, 0.0001)
+	server.AssertRequestCount(t, priceQueryPath, 3)
+}
+
+func TestRetry_StopsAfterMaxAttempts(t *testing.T) {
+	server := newFlakyServer(t, priceQueryPath, 10, http.StatusTooManyRequests, nil)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRetryPolicy(fastRetryPolicy()),
+	)
+
+	_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.Error(t, err)
+	server.AssertRequestCount(t, priceQueryPath, 3)
+}
+
+func TestRetry_ClientErrorNotRetried(t *testing.T) {
+	server := newFlakyServer(t, priceQueryPath, 1, http.StatusBadRequest, nil)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRetryPolicy(fastRetryPolicy()),
+	)
+
+	_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.Error(t, err)
+	server.AssertRequestCount(t, priceQueryPath, 1)
+}
+
+func TestRetry_HonorsRetryAfter(t *testing.T) {
+	server := newFlakyServer(t, priceQueryPath, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"1"}})
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRetryPolicy(fastRetryPolicy()),
+	)
+
+	start := time.Now()
+	_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second, "Retry-After is not capped by MaxBackoff")
+	server.AssertRequestCount(t, priceQueryPath, 2)
+}
+
+func TestRetry_GivesUpBeyondMaxRetryAfter(t *testing.T) {
+	server := newFlakyServer(t, priceQueryPath, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"86400"}})
+	policy := fastRetryPolicy()
+	policy.MaxRetryAfter = time.Second
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRetryPolicy(policy),
+	)
+
+	start := time.Now()
+	_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.ErrorIs(t, err, api.ErrRateLimited)
+	assert.Less(t, time.Since(start), time.Second)
+	server.AssertRequestCount(t, priceQueryPath, 1)
+}
+
+func TestRetry_RespectsContextDeadline(t *testing.T) {
+	server := newFlakyServer(t, priceQueryPath, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"10"}})
+	policy := fastRetryPolicy()
+	policy.MaxBackoff = time.Minute
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRetryPolicy(policy),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetTokenPriceV1(ctx, priceRequest)
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	server.AssertRequestCount(t, priceQueryPath, 1)
+}
+
+func TestRetry_MutationsNotRetriedByDefault(t *testing.T) {
+	server := newFlakyServer(t, trackedWalletsPath, 1, http.StatusServiceUnavailable, nil)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRetryPolicy(fastRetryPolicy()),
+	)
+
+	_, err := client.AddTrackedWalletsV1(context.Background(), &apiv1.AddTrackedWalletRequest{Wallet: "0x123", Label: "test"})
+	require.Error(t, err)
+	server.AssertRequestCount(t, trackedWalletsPath, 1)
+}
+
+func TestRetry_MutationsRetriedWhenEnabled(t *testing.T) {
+	server := newFlakyServer(t, trackedWalletsPath, 1, http.StatusServiceUnavailable, nil)
+	policy := fastRetryPolicy()
+	policy.RetryMutations = true
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithRetryPolicy(policy),
+	)
+
+	_, err := client.AddTrackedWalletsV1(context.Background(), &apiv1.AddTrackedWalletRequest{Wallet: "0x123", Label: "test"})
+	require.NoError(t, err)
+	server.AssertRequestCount(t, trackedWalletsPath, 2)
+}
+
+func TestRetry_DisabledWithoutPolicy(t *testing.T) {
+	server := newFlakyServer(t, priceQueryPath, 1, http.StatusServiceUnavailable, nil)
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL))
+
+	_, err := client.GetTokenPriceV1(context.Background(), priceRequest)
+	require.Error(t, err)
+	server.AssertRequestCount(t, priceQueryPath, 1)
+}
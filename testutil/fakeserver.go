@@ -0,0 +1,815 @@
+package testutil
+
+import (
+	"cmp"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sealtv/cielogo/api/apiv1"
+)
+
+// DefaultFakePageSize is the page size used by FakeServer unless its PageSize is set.
+const DefaultFakePageSize = 20
+
+// maxFeedLimit is the maximum feed page size accepted by the API.
+const maxFeedLimit = 100
+
+// FakeServer is a stateful in-memory Cielo API. It implements tracked wallets, wallet lists,
+// toggle-follow, wallet tags and the paginated feed, and serves a WebSocket endpoint at /v1/ws
+// (see WebsocketURL and PushTxEvents). Errors use the API's {"status":"error","message":...} envelope,
+// so they surface as *api.Error on the client side.
+//
+// Point a client at it with cielogo.WithBaseURL(server.URL) and cielogo.WithWebsocketURL(server.WebsocketURL()).
+// Requests without an X-Api-Key header are rejected with 401.
+type FakeServer struct {
+	*httptest.Server
+
+	// PageSize is the number of items per page of paginated endpoints, when the request sets no limit.
+	PageSize int
+
+	mu      sync.Mutex
+	nextID  int64
+	wallets []apiv1.TrackedWallet
+	lists   []apiv1.WalletList
+	tags    map[string][]apiv1.Tag
+	feed    []apiv1.TxEvent
+
+	ws fakeWebsockets
+}
+
+// NewFakeServer starts a fake Cielo API, closed when the test ends.
+func NewFakeServer(t *testing.T) *FakeServer {
+	t.Helper()
+
+	s := &FakeServer{
+		PageSize: DefaultFakePageSize,
+		nextID:   1,
+		tags:     make(map[string][]apiv1.Tag),
+		ws:       fakeWebsockets{conns: make(map[*fakeWebsocketConn]struct{})},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeError(w, http.StatusNotFound, "route not found")
+	})
+	mux.HandleFunc("GET /v1/ws", s.serveWebsocket)
+	mux.HandleFunc("GET /v1/feed/{$}", s.getFeed)
+	mux.HandleFunc("GET /v1/tracked-wallets", s.getTrackedWallets)
+	mux.HandleFunc("POST /v1/tracked-wallets", s.addTrackedWallet)
+	mux.HandleFunc("DELETE /v1/tracked-wallets", s.removeTrackedWallets)
+	mux.HandleFunc("GET /v1/tracked-wallets/address/{wallet}", s.getWalletByAddress)
+	mux.HandleFunc("PUT /v1/tracked-wallets/{id}", s.updateTrackedWallet)
+	mux.HandleFunc("PUT /v2/tracked-wallets/{wallet}", s.updateTrackedWalletV2)
+	mux.HandleFunc("GET /v1/lists", s.getUserLists)
+	mux.HandleFunc("POST /v1/lists", s.addList)
+	mux.HandleFunc("GET /v1/lists/all", s.getAllLists)
+	mux.HandleFunc("PUT /v1/lists/{id}", s.updateList)
+	mux.HandleFunc("DELETE /v1/lists/{id}", s.deleteList)
+	mux.HandleFunc("PUT /v1/lists/{id}/toggle-follow", s.toggleFollowList)
+	mux.HandleFunc("GET /v1/{wallet}/tags", s.getWalletTags)
+	mux.HandleFunc("GET /v1/tags", s.getWalletsTags)
+	mux.HandleFunc("GET /v1/tags/wallets", s.getWalletsByTag)
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") == "" {
+			writeFakeError(w, http.StatusUnauthorized, "missing api key")
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	}))
+
+	t.Cleanup(func() {
+		s.ws.closeAll()
+		s.Close()
+	})
+
+	return s
+}
+
+// AddPublicList adds a public list created by another user, e.g. to follow it.
+// The list is assigned an ID, returned in the result.
+func (s *FakeServer) AddPublicList(list apiv1.WalletList) apiv1.WalletList {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list.ID = s.newID()
+	list.IsPublic = true
+	list.IsCreator = false
+	if list.CreatedAt == 0 {
+		list.CreatedAt = time.Now().Unix()
+	}
+	s.lists = append(s.lists, list)
+
+	return list
+}
+
+// SetWalletTags sets the tags of a wallet.
+func (s *FakeServer) SetWalletTags(wallet string, tags ...apiv1.Tag) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tags[wallet] = tags
+}
+
+// AddFeedEvents adds events to the feed. The feed is served newest first.
+func (s *FakeServer) AddFeedEvents(events ...apiv1.TxEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.feed = append(s.feed, events...)
+	slices.SortStableFunc(s.feed, func(a, b apiv1.TxEvent) int {
+		return cmp.Compare(b.Timestamp, a.Timestamp)
+	})
+}
+
+// TrackedWallets returns the tracked wallets, in the order they were added.
+func (s *FakeServer) TrackedWallets() []apiv1.TrackedWallet {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wallets := make([]apiv1.TrackedWallet, 0, len(s.wallets))
+	for _, w := range s.wallets {
+		wallets = append(wallets, s.withList(w))
+	}
+
+	return wallets
+}
+
+// Lists returns every list, including the public lists of other users.
+func (s *FakeServer) Lists() []apiv1.WalletList {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lists := make([]apiv1.WalletList, 0, len(s.lists))
+	for _, l := range s.lists {
+		lists = append(lists, s.withCount(l))
+	}
+
+	return lists
+}
+
+// Feed
+
+func (s *FakeServer) getFeed(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	limit, offset, ok := s.paging(w, q.Get("limit"), q.Get("startFrom"))
+	if !ok {
+		return
+	}
+	if limit > maxFeedLimit {
+		writeFakeError(w, http.StatusBadRequest, "limit must be at most 100")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var listWallets []string
+	if list := q.Get("list"); list != "" {
+		id, err := strconv.ParseInt(list, 10, 64)
+		if err != nil || s.list(id) < 0 {
+			writeFakeError(w, http.StatusNotFound, "list not found")
+			return
+		}
+		listWallets = s.walletsInList(id)
+	}
+
+	filter := feedFilter{
+		wallet:  q.Get("wallet"),
+		list:    listWallets,
+		hasList: q.Get("list") != "",
+		chains:  splitComma(q.Get("chains")),
+		txTypes: splitComma(q.Get("tx_types")),
+		from:    parseInt64(q.Get("fromTimestamp")),
+		to:      parseInt64(q.Get("toTimestamp")),
+	}
+
+	var items []json.RawMessage
+	for _, event := range s.feed {
+		if !filter.match(event) {
+			continue
+		}
+
+		raw, err := json.Marshal(event)
+		if err != nil {
+			writeFakeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		items = append(items, raw)
+	}
+
+	page, next := paginate(items, offset, limit)
+	writeFakeData(w, map[string]any{
+		"items":  page,
+		"paging": apiv1.Pagination{HasNextPage: next > 0, TotalRowsInPage: len(page), NextObject: cursor(next)},
+	})
+}
+
+type feedFilter struct {
+	wallet   string
+	list     []string
+	hasList  bool
+	chains   []string
+	txTypes  []string
+	from, to int64
+}
+
+func (f feedFilter) match(event apiv1.TxEvent) bool {
+	switch {
+	case f.wallet != "" && event.Wallet != f.wallet,
+		f.hasList && !slices.Contains(f.list, event.Wallet),
+		len(f.chains) > 0 && !slices.Contains(f.chains, string(event.Chain)),
+		len(f.txTypes) > 0 && !slices.Contains(f.txTypes, string(event.TxType)),
+		f.from > 0 && event.Timestamp < f.from,
+		f.to > 0 && event.Timestamp > f.to:
+		return false
+	default:
+		return true
+	}
+}
+
+// Tracked wallets
+
+func (s *FakeServer) getTrackedWallets(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	limit, offset, ok := s.paging(w, "", q.Get("next_object"))
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	listID := parseInt64(q.Get("list_id"))
+	var wallets []apiv1.TrackedWallet
+	for _, wallet := range s.wallets {
+		if listID != 0 && (wallet.ListID == nil || *wallet.ListID != listID) {
+			continue
+		}
+		wallets = append(wallets, s.withList(wallet))
+	}
+
+	page, next := paginate(wallets, offset, limit)
+	writeFakeData(w, apiv1.GetTrackedWalletsResponse{
+		TrackedWallets: page,
+		Pagination:     apiv1.TrackedWalletPagination{HasNextPage: next > 0, TotalRowsInPage: len(page), NextObject: next},
+	})
+}
+
+func (s *FakeServer) addTrackedWallet(w http.ResponseWriter, r *http.Request) {
+	var req apiv1.AddTrackedWalletRequest
+	if !decodeFakeBody(w, r, &req) {
+		return
+	}
+
+	if req.Wallet == "" || req.Label == "" {
+		writeFakeError(w, http.StatusBadRequest, "wallet and label are required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.wallet(req.Wallet) >= 0 {
+		writeFakeError(w, http.StatusConflict, "wallet is already tracked")
+		return
+	}
+
+	listID := req.ListID
+	if listID == nil {
+		listID = req.BundleID
+	}
+	if listID != nil && s.list(*listID) < 0 {
+		writeFakeError(w, http.StatusNotFound, "list not found")
+		return
+	}
+
+	wallet := apiv1.TrackedWallet{
+		ID:     s.newID(),
+		Wallet: req.Wallet,
+		Label:  req.Label,
+		Type:   fakeWalletType(req.Wallet),
+		ListID: listID,
+	}
+	s.wallets = append(s.wallets, wallet)
+
+	writeFakeData(w, s.withList(wallet))
+}
+
+func (s *FakeServer) removeTrackedWallets(w http.ResponseWriter, r *http.Request) {
+	var req apiv1.RemoveTrackedWalletsRequest
+	if !decodeFakeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.wallets = slices.DeleteFunc(s.wallets, func(wallet apiv1.TrackedWallet) bool {
+		return slices.Contains(req.WalletIDs, wallet.ID)
+	})
+
+	writeFakeData(w, struct{}{})
+}
+
+func (s *FakeServer) getWalletByAddress(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.wallet(r.PathValue("wallet"))
+	if i < 0 {
+		writeFakeError(w, http.StatusNotFound, "wallet not tracked")
+		return
+	}
+
+	writeFakeData(w, s.withList(s.wallets[i]))
+}
+
+func (s *FakeServer) updateTrackedWallet(w http.ResponseWriter, r *http.Request) {
+	var req apiv1.UpdateTrackedWalletRequest
+	if !decodeFakeBody(w, r, &req) {
+		return
+	}
+
+	if req.Wallet == "" || req.Label == "" {
+		writeFakeError(w, http.StatusBadRequest, "wallet and label are required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := slices.IndexFunc(s.wallets, func(wallet apiv1.TrackedWallet) bool {
+		return strconv.FormatInt(wallet.ID, 10) == r.PathValue("id")
+	})
+	if i < 0 {
+		writeFakeError(w, http.StatusNotFound, "wallet not tracked")
+		return
+	}
+
+	if req.ListID != nil && s.list(*req.ListID) < 0 {
+		writeFakeError(w, http.StatusNotFound, "list not found")
+		return
+	}
+
+	wallet := &s.wallets[i]
+	wallet.Wallet, wallet.Label, wallet.ListID = req.Wallet, req.Label, req.ListID
+	wallet.Type = fakeWalletType(req.Wallet)
+
+	writeFakeData(w, s.withList(*wallet))
+}
+
+func (s *FakeServer) updateTrackedWalletV2(w http.ResponseWriter, r *http.Request) {
+	var req apiv1.UpdateTrackedWalletV2Request
+	if !decodeFakeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.wallet(r.PathValue("wallet"))
+	if i < 0 {
+		writeFakeError(w, http.StatusNotFound, "wallet not tracked")
+		return
+	}
+
+	wallet := &s.wallets[i]
+	if req.Label != nil {
+		wallet.Label = *req.Label
+	}
+	if req.ListID != nil {
+		listID := int64(*req.ListID)
+		if s.list(listID) < 0 {
+			writeFakeError(w, http.StatusNotFound, "list not found")
+			return
+		}
+		wallet.ListID = &listID
+	}
+
+	writeFakeData(w, s.withList(*wallet))
+}
+
+// Lists
+
+func (s *FakeServer) getUserLists(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lists := []apiv1.WalletList{}
+	for _, list := range s.lists {
+		if list.IsCreator {
+			lists = append(lists, s.withCount(list))
+		}
+	}
+
+	writeFakeData(w, lists)
+}
+
+func (s *FakeServer) addList(w http.ResponseWriter, r *http.Request) {
+	var req apiv1.AddWalletsListRequest
+	if !decodeFakeBody(w, r, &req) {
+		return
+	}
+
+	if req.Name == "" {
+		writeFakeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := apiv1.WalletList{
+		ID:          s.newID(),
+		Name:        req.Name,
+		Description: req.Description,
+		IsPublic:    req.IsPublic,
+		IsCreator:   true,
+		CreatedAt:   time.Now().Unix(),
+	}
+	s.lists = append(s.lists, list)
+	s.assignWallets(list.ID, req.Wallets)
+
+	writeFakeData(w, s.withCount(list))
+}
+
+func (s *FakeServer) updateList(w http.ResponseWriter, r *http.Request) {
+	var req apiv1.UpdateWalletsListRequest
+	if !decodeFakeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.ownList(w, r)
+	if !ok {
+		return
+	}
+
+	list := &s.lists[i]
+	if req.Name != "" {
+		list.Name = req.Name
+	}
+	list.Description = req.Description
+	list.IsPublic = req.IsPublic
+	s.assignWallets(list.ID, req.Wallets)
+
+	writeFakeData(w, s.withCount(*list))
+}
+
+func (s *FakeServer) deleteList(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.ownList(w, r)
+	if !ok {
+		return
+	}
+
+	id := s.lists[i].ID
+	s.lists = slices.Delete(s.lists, i, i+1)
+
+	deleteWallets := r.URL.Query().Get("delete_wallets") == "true"
+	s.wallets = slices.DeleteFunc(s.wallets, func(wallet apiv1.TrackedWallet) bool {
+		return deleteWallets && wallet.ListID != nil && *wallet.ListID == id
+	})
+	for j := range s.wallets {
+		if s.wallets[j].ListID != nil && *s.wallets[j].ListID == id {
+			s.wallets[j].ListID = nil
+		}
+	}
+
+	writeFakeData(w, struct{}{})
+}
+
+func (s *FakeServer) toggleFollowList(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.list(parseInt64(r.PathValue("id")))
+	if i < 0 {
+		writeFakeError(w, http.StatusNotFound, "list not found")
+		return
+	}
+
+	list := &s.lists[i]
+	list.Followed = !list.Followed
+	if list.Followed {
+		list.FollowedCount++
+	} else {
+		list.FollowedCount--
+	}
+
+	// The client decodes this endpoint without the data envelope.
+	writeFakeJSON(w, http.StatusOK, map[string]any{
+		"status":   "ok",
+		"followed": list.Followed,
+		"data":     apiv1.ToggleFollowWalletsListResponce{Followed: list.Followed},
+	})
+}
+
+func (s *FakeServer) getAllLists(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	limit, offset, ok := s.paging(w, "", q.Get("next_object"))
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var lists []apiv1.WalletList
+	for _, list := range s.lists {
+		if !list.IsPublic || (q.Get("follow_only") == "true" && !list.Followed) {
+			continue
+		}
+		lists = append(lists, s.withCount(list))
+	}
+
+	if apiv1.WalletsListOrdering(q.Get("order")) == apiv1.NewWalletsListOrdering {
+		slices.SortStableFunc(lists, func(a, b apiv1.WalletList) int { return cmp.Compare(b.CreatedAt, a.CreatedAt) })
+	} else {
+		slices.SortStableFunc(lists, func(a, b apiv1.WalletList) int { return cmp.Compare(b.FollowedCount, a.FollowedCount) })
+	}
+
+	page, next := paginate(lists, offset, limit)
+	writeFakeData(w, apiv1.GetAllWalletsListsResponse{
+		List:   page,
+		Paging: apiv1.Pagination{HasNextPage: next > 0, TotalRowsInPage: len(page), NextObject: cursor(next)},
+	})
+}
+
+// Tags
+
+func (s *FakeServer) getWalletTags(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeFakeData(w, apiv1.GetWalletTagsResponse{Tags: s.walletTags(r.PathValue("wallet"))})
+}
+
+func (s *FakeServer) getWalletsTags(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := []apiv1.WalletTags{}
+	for _, wallet := range r.URL.Query()["wallet"] {
+		result = append(result, apiv1.WalletTags{Wallet: wallet, Tags: s.walletTags(wallet)})
+	}
+
+	writeFakeData(w, result)
+}
+
+func (s *FakeServer) getWalletsByTag(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	limit, offset, ok := s.paging(w, q.Get("limit"), q.Get("next_object"))
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wanted := q["tags"]
+	walletType := apiv1.WalletType(q.Get("wallet_type"))
+
+	var wallets []apiv1.Wallet
+	for _, wallet := range sortedMapKeys(s.tags) {
+		if walletType != "" && fakeWalletType(wallet) != walletType {
+			continue
+		}
+
+		hasAll := true
+		for _, tag := range wanted {
+			hasAll = hasAll && slices.ContainsFunc(s.tags[wallet], func(t apiv1.Tag) bool { return string(t.Key) == tag })
+		}
+		if hasAll {
+			wallets = append(wallets, apiv1.Wallet{Wallet: wallet, WalletType: fakeWalletType(wallet)})
+		}
+	}
+
+	page, next := paginate(wallets, offset, limit)
+	writeFakeData(w, apiv1.GetWalletsByTagResponse{
+		Wallets: page,
+		Paging:  apiv1.WalletsByTagPaging{HasNextPage: next > 0, NextPage: next},
+	})
+}
+
+// Helpers. Methods below must be called with s.mu held.
+
+func (s *FakeServer) newID() int64 {
+	id := s.nextID
+	s.nextID++
+
+	return id
+}
+
+func (s *FakeServer) wallet(address string) int {
+	return slices.IndexFunc(s.wallets, func(w apiv1.TrackedWallet) bool { return w.Wallet == address })
+}
+
+func (s *FakeServer) list(id int64) int {
+	return slices.IndexFunc(s.lists, func(l apiv1.WalletList) bool { return l.ID == id })
+}
+
+// ownList returns the index of the list in the path, writing an error unless it exists and is owned by the user.
+func (s *FakeServer) ownList(w http.ResponseWriter, r *http.Request) (int, bool) {
+	i := s.list(parseInt64(r.PathValue("id")))
+	if i < 0 {
+		writeFakeError(w, http.StatusNotFound, "list not found")
+		return 0, false
+	}
+
+	if !s.lists[i].IsCreator {
+		writeFakeError(w, http.StatusForbidden, "list is owned by another user")
+		return 0, false
+	}
+
+	return i, true
+}
+
+func (s *FakeServer) walletsInList(id int64) []string {
+	var wallets []string
+	for _, wallet := range s.wallets {
+		if wallet.ListID != nil && *wallet.ListID == id {
+			wallets = append(wallets, wallet.Wallet)
+		}
+	}
+
+	return wallets
+}
+
+// assignWallets moves the wallets to the list, tracking the ones that are not tracked yet.
+func (s *FakeServer) assignWallets(listID int64, wallets []string) {
+	for _, address := range wallets {
+		id := listID
+		if i := s.wallet(address); i >= 0 {
+			s.wallets[i].ListID = &id
+			continue
+		}
+
+		s.wallets = append(s.wallets, apiv1.TrackedWallet{
+			ID:     s.newID(),
+			Wallet: address,
+			Label:  address,
+			Type:   fakeWalletType(address),
+			ListID: &id,
+		})
+	}
+}
+
+func (s *FakeServer) withCount(list apiv1.WalletList) apiv1.WalletList {
+	if list.IsCreator {
+		list.WalletsCount = int64(len(s.walletsInList(list.ID)))
+	}
+
+	return list
+}
+
+func (s *FakeServer) withList(wallet apiv1.TrackedWallet) apiv1.TrackedWallet {
+	if wallet.ListID != nil {
+		if i := s.list(*wallet.ListID); i >= 0 {
+			list := s.withCount(s.lists[i])
+			wallet.List = &list
+		}
+	}
+
+	return wallet
+}
+
+func (s *FakeServer) walletTags(wallet string) []apiv1.Tag {
+	if tags := s.tags[wallet]; tags != nil {
+		return tags
+	}
+
+	return []apiv1.Tag{}
+}
+
+// paging parses the page size and offset of a paginated request.
+func (s *FakeServer) paging(w http.ResponseWriter, limit, cursor string) (int, int, bool) {
+	size := s.PageSize
+	if size <= 0 {
+		size = DefaultFakePageSize
+	}
+
+	if limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			writeFakeError(w, http.StatusBadRequest, "invalid limit")
+			return 0, 0, false
+		}
+		size = n
+	}
+
+	offset := 0
+	if cursor != "" {
+		n, err := strconv.Atoi(cursor)
+		if err != nil || n < 0 {
+			writeFakeError(w, http.StatusBadRequest, "invalid cursor")
+			return 0, 0, false
+		}
+		offset = n
+	}
+
+	return size, offset, true
+}
+
+// paginate returns the page of items starting at offset, and the offset of the next page (0 if none).
+func paginate[T any](items []T, offset, limit int) ([]T, int) {
+	if offset >= len(items) {
+		return []T{}, 0
+	}
+
+	end := min(offset+limit, len(items))
+	next := 0
+	if end < len(items) {
+		next = end
+	}
+
+	return items[offset:end], next
+}
+
+func cursor(next int) string {
+	if next == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(next)
+}
+
+// fakeWalletType guesses the wallet type from the address format.
+func fakeWalletType(address string) apiv1.WalletType {
+	switch {
+	case strings.HasPrefix(address, "0x"):
+		return apiv1.EvmWalletType
+	case strings.HasPrefix(address, "T"):
+		return apiv1.TronWalletType
+	case strings.HasPrefix(address, "bc1"):
+		return apiv1.BitcoinWalletType
+	case strings.HasPrefix(address, "dydx1"):
+		return apiv1.DydxWalletType
+	default:
+		return apiv1.SolanaWalletType
+	}
+}
+
+func decodeFakeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeFakeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+
+	return true
+}
+
+func writeFakeData(w http.ResponseWriter, data any) {
+	writeFakeJSON(w, http.StatusOK, map[string]any{"status": "ok", "data": data})
+}
+
+func writeFakeError(w http.ResponseWriter, status int, message string) {
+	writeFakeJSON(w, status, map[string]string{"status": "error", "message": message})
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func splitComma(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, ",")
+}
+
+func parseInt64(s string) int64 {
+	n, _ := strconv.ParseInt(s, 10, 64)
+
+	return n
+}
+
+func sortedMapKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
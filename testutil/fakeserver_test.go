@@ -0,0 +1,247 @@
+package testutil_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/api/chains"
+	"github.com/sealtv/cielogo/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeClient(t *testing.T) (*testutil.FakeServer, *cielogo.Client) {
+	t.Helper()
+
+	server := testutil.NewFakeServer(t)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithWebsocketURL(server.WebsocketURL()),
+	)
+
+	return server, client
+}
+
+func TestFakeServer_TrackedWallets(t *testing.T) {
+	server, client := newFakeClient(t)
+	ctx := context.Background()
+
+	list, err := client.AddWalletsListV1(ctx, &apiv1.AddWalletsListRequest{Name: "whales"})
+	require.NoError(t, err)
+
+	added, err := client.AddTrackedWalletsV1(ctx, &apiv1.AddTrackedWalletRequest{Wallet: "0xabc", Label: "whale", ListID: &list.ID})
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.EvmWalletType, added.Type)
+	require.NotNil(t, added.List)
+	assert.Equal(t, int64(1), added.List.WalletsCount)
+
+	_, err = client.AddTrackedWalletsV1(ctx, &apiv1.AddTrackedWalletRequest{Wallet: "0xabc", Label: "again"})
+	require.Error(t, err)
+	_, err = client.AddTrackedWalletsV1(ctx, &apiv1.AddTrackedWalletRequest{Wallet: "0xdef"})
+	require.ErrorIs(t, err, api.ErrInvalidRequest)
+
+	unvalidated := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL), cielogo.WithoutRequestValidation())
+	_, err = unvalidated.AddTrackedWalletsV1(ctx, &apiv1.AddTrackedWalletRequest{Wallet: "0xdef"})
+	var apiErr *api.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 400, apiErr.StatusCode)
+	assert.Equal(t, "wallet and label are required", apiErr.Message)
+
+	label := "big whale"
+	updated, err := client.UpdateTrackedWalletV2(ctx, "0xabc", &apiv1.UpdateTrackedWalletV2Request{Label: &label})
+	require.NoError(t, err)
+	assert.Equal(t, "big whale", updated.Label)
+
+	updated, err = client.UpdateTrackedWalletV1(ctx, added.ID, &apiv1.UpdateTrackedWalletRequest{Wallet: "SoLwallet", Label: "moved"})
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.SolanaWalletType, updated.Type)
+	assert.Nil(t, updated.ListID)
+
+	got, err := client.GetWalletByAddressV1(ctx, "SoLwallet")
+	require.NoError(t, err)
+	assert.Equal(t, "moved", got.Label)
+
+	tracked, err := client.GetTrackedWalletsV1(ctx, &apiv1.GetTrackedWalletsRequest{})
+	require.NoError(t, err)
+	require.Len(t, tracked.TrackedWallets, 1)
+
+	require.NoError(t, client.RemoveTrackedWalletsV1(ctx, &apiv1.RemoveTrackedWalletsRequest{WalletIDs: []int64{added.ID}}))
+	_, err = client.GetWalletByAddressV1(ctx, "SoLwallet")
+	require.ErrorIs(t, err, api.ErrNotFound)
+	assert.Empty(t, server.TrackedWallets())
+}
+
+func TestFakeServer_Lists(t *testing.T) {
+	server, client := newFakeClient(t)
+	ctx := context.Background()
+
+	mine, err := client.AddWalletsListV1(ctx, &apiv1.AddWalletsListRequest{Name: "mine", Wallets: []string{"0x1", "0x2"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), mine.WalletsCount)
+	assert.True(t, mine.IsCreator)
+
+	mine, err = client.UpdateWalletsListV1(ctx, &apiv1.UpdateWalletsListRequest{ListID: mine.ID, Name: "renamed", IsPublic: true, Wallets: []string{"0x3"}})
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", mine.Name)
+	assert.Equal(t, int64(3), mine.WalletsCount)
+
+	other := server.AddPublicList(apiv1.WalletList{Name: "smart money", FollowedCount: 10})
+	_, err = client.UpdateWalletsListV1(ctx, &apiv1.UpdateWalletsListRequest{ListID: other.ID, Name: "stolen"})
+	require.ErrorIs(t, err, api.ErrUnauthorized)
+
+	followed, err := client.ToggleFollowWalletsListV1(ctx, other.ID)
+	require.NoError(t, err)
+	assert.True(t, followed.Followed)
+
+	all, err := client.GetAllWalletsListV1(ctx, &apiv1.GetAllWalletsListsRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.List, 2)
+	assert.Equal(t, "smart money", all.List[0].Name, "popular lists come first")
+	assert.Equal(t, int64(11), all.List[0].FollowedCount)
+
+	all, err = client.GetAllWalletsListV1(ctx, &apiv1.GetAllWalletsListsRequest{FollowOnly: true})
+	require.NoError(t, err)
+	require.Len(t, all.List, 1)
+
+	lists, err := client.GetUserWalletsListsV1(ctx)
+	require.NoError(t, err)
+	require.Len(t, lists, 1)
+
+	require.NoError(t, client.DeleteWalletsListV1(ctx, mine.ID, true))
+	assert.Empty(t, server.TrackedWallets())
+	err = client.DeleteWalletsListV1(ctx, mine.ID, false)
+	require.ErrorIs(t, err, api.ErrNotFound)
+}
+
+func TestFakeServer_Tags(t *testing.T) {
+	server, client := newFakeClient(t)
+	server.PageSize = 1
+	ctx := context.Background()
+
+	gem := apiv1.Tag{Key: apiv1.TagTypeGemFinder, Tag: "Gem Finder"}
+	whale := apiv1.Tag{Key: apiv1.TagTypeNewWhale, Tag: "New Whale"}
+	server.SetWalletTags("0x1", gem, whale)
+	server.SetWalletTags("0x2", gem)
+	server.SetWalletTags("SoL", gem)
+
+	tags, err := client.GetWalletTagsV1(ctx, &apiv1.GetWalletTagsRequest{Wallet: "0x1"})
+	require.NoError(t, err)
+	assert.Equal(t, []apiv1.Tag{gem, whale}, tags.Tags)
+
+	walletsTags, err := client.GetWalletsTagsV1(ctx, &apiv1.GetWalletsTagsRequest{Wallets: []string{"0x2", "unknown"}})
+	require.NoError(t, err)
+	assert.Equal(t, []apiv1.WalletTags{{Wallet: "0x2", Tags: []apiv1.Tag{gem}}, {Wallet: "unknown", Tags: []apiv1.Tag{}}}, walletsTags)
+
+	evm := apiv1.EvmWalletType
+	page, err := client.GetWalletsByTagV1(ctx, &apiv1.GetWalletsByTagRequest{Tags: []apiv1.TagType{apiv1.TagTypeGemFinder}, WalletType: &evm})
+	require.NoError(t, err)
+	assert.Equal(t, []apiv1.Wallet{{Wallet: "0x1", WalletType: evm}}, page.Wallets)
+	assert.True(t, page.Paging.HasNextPage)
+	assert.Equal(t, 1, page.Paging.NextPage)
+
+	byBoth, err := client.GetWalletsByTagV1(ctx, &apiv1.GetWalletsByTagRequest{
+		Tags:  []apiv1.TagType{apiv1.TagTypeGemFinder, apiv1.TagTypeNewWhale},
+		Limit: testutil.Ptr(10),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []apiv1.Wallet{{Wallet: "0x1", WalletType: evm}}, byBoth.Wallets)
+	assert.False(t, byBoth.Paging.HasNextPage)
+}
+
+func TestFakeServer_Feed(t *testing.T) {
+	server, client := newFakeClient(t)
+	ctx := context.Background()
+
+	for i := range 5 {
+		server.AddFeedEvents(apiv1.TxEvent{
+			Wallet:    "0x1",
+			TxHash:    "0xhash" + string(rune('a'+i)),
+			TxType:    apiv1.TxTypeSwap,
+			Chain:     chains.Ethereum,
+			Timestamp: int64(1000 + i),
+			Data:      &apiv1.SwapEvent{TokenSymbol: "PEPE", AmountUsd: 100},
+		})
+	}
+	server.AddFeedEvents(apiv1.TxEvent{Wallet: "0x2", TxType: apiv1.TxTypeTransfer, Chain: chains.Base, Timestamp: 2000})
+
+	feed, err := client.GetFeedV1(ctx, &apiv1.FeedRequest{Wallet: "0x1", Limit: testutil.Ptr(2)})
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 2)
+	assert.Equal(t, int64(1004), feed.Items[0].Timestamp, "newest first")
+	swap, ok := feed.Items[0].Data.(*apiv1.SwapEvent)
+	require.True(t, ok)
+	assert.Equal(t, "PEPE", swap.TokenSymbol)
+	assert.Equal(t, "0x1", swap.Wallet)
+	assert.True(t, feed.Paging.HasNextPage)
+
+	next, err := client.GetFeedV1(ctx, &apiv1.FeedRequest{Wallet: "0x1", Limit: testutil.Ptr(2), StartFrom: &feed.Paging.NextObject})
+	require.NoError(t, err)
+	require.Len(t, next.Items, 2)
+	assert.Equal(t, int64(1002), next.Items[0].Timestamp)
+
+	transfers, err := client.GetFeedV1(ctx, &apiv1.FeedRequest{TxTypes: []apiv1.TxType{apiv1.TxTypeTransfer}})
+	require.NoError(t, err)
+	require.Len(t, transfers.Items, 1)
+	assert.Equal(t, chains.Base, transfers.Items[0].Chain)
+
+	_, err = client.GetFeedV1(ctx, &apiv1.FeedRequest{Limit: testutil.Ptr(101)})
+	require.Error(t, err)
+}
+
+func TestFakeServer_Unauthorized(t *testing.T) {
+	server := testutil.NewFakeServer(t)
+	client := cielogo.NewClient("", cielogo.WithBaseURL(server.URL))
+
+	_, err := client.GetUserWalletsListsV1(context.Background())
+	require.ErrorIs(t, err, api.ErrUnauthorized)
+}
+
+func TestFakeServer_Websocket(t *testing.T) {
+	server, client := newFakeClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	ws, err := client.NewWebsocketConnection(ctx)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	events := make(chan apiv1.WSEvent, 10)
+	done := make(chan error, 1)
+	go func() { done <- ws.RunListener(ctx, events) }()
+
+	require.NoError(t, ws.SendCommand(&apiv1.WalletSubscribeCmd{
+		Wallet: "0x1",
+		Filter: &apiv1.Filter{TxTypes: []apiv1.TxType{apiv1.TxTypeSwap}},
+	}))
+	ack := <-events
+	assert.Equal(t, apiv1.WalletSubscribedEventType, ack.Type)
+
+	sent := server.PushTxEvents(
+		apiv1.TxEvent{Wallet: "0x1", TxType: apiv1.TxTypeSwap, Chain: chains.Ethereum, Data: &apiv1.SwapEvent{TokenSymbol: "PEPE"}},
+		apiv1.TxEvent{Wallet: "0x1", TxType: apiv1.TxTypeTransfer, Chain: chains.Ethereum},
+		apiv1.TxEvent{Wallet: "0x2", TxType: apiv1.TxTypeSwap, Chain: chains.Ethereum},
+	)
+	assert.Equal(t, 1, sent)
+
+	event := <-events
+	require.Equal(t, apiv1.TxEventType, event.Type)
+	tx, ok := event.Data.(apiv1.TxEvent)
+	require.True(t, ok)
+	assert.Equal(t, "0x1", tx.Wallet)
+	swap, ok := tx.Data.(*apiv1.SwapEvent)
+	require.True(t, ok)
+	assert.Equal(t, "PEPE", swap.TokenSymbol)
+
+	require.NoError(t, ws.SendCommand(&apiv1.FeedSubscribeCmd{}))
+	assert.Equal(t, apiv1.FeedSubscribedEventType, (<-events).Type)
+	assert.Equal(t, 1, server.PushTxEvents(apiv1.TxEvent{Wallet: "0x2", TxType: apiv1.TxTypeTransfer, Chain: chains.Base}))
+	assert.Equal(t, apiv1.TxEventType, (<-events).Type)
+
+	server.CloseWebsockets(websocket.CloseGoingAway, "restart")
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,222 @@
+package testutil
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sealtv/cielogo/api/apiv1"
+)
+
+// fakeWebsockets tracks the open WebSocket connections of a FakeServer.
+type fakeWebsockets struct {
+	mu    sync.Mutex
+	conns map[*fakeWebsocketConn]struct{}
+}
+
+// fakeWebsocketConn is a WebSocket connection and its subscriptions.
+type fakeWebsocketConn struct {
+	conn *websocket.Conn
+
+	mu      sync.Mutex
+	wallets map[string]*apiv1.Filter
+	feed    *fakeFeedSubscription
+}
+
+type fakeFeedSubscription struct {
+	listID *int64
+	filter *apiv1.Filter
+}
+
+// fakeCommand is any command sent by the client.
+type fakeCommand struct {
+	Type   apiv1.CommandType `json:"type"`
+	Wallet string            `json:"wallet"`
+	ListID *int64            `json:"list_id"`
+	Filter *apiv1.Filter     `json:"filter"`
+}
+
+// WebsocketURL returns the URL of the WebSocket endpoint, for cielogo.WithWebsocketURL.
+func (s *FakeServer) WebsocketURL() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http") + "/v1/ws"
+}
+
+// WebsocketConnections returns the number of open WebSocket connections.
+func (s *FakeServer) WebsocketConnections() int {
+	s.ws.mu.Lock()
+	defer s.ws.mu.Unlock()
+
+	return len(s.ws.conns)
+}
+
+// PushTxEvents sends the events to every connection subscribed to them and returns the number of
+// messages sent. A wallet subscription receives the events of its wallet; a feed subscription receives
+// every event, or the events of the wallets tracked in its list. Subscription filters on tx types
+// and chains are applied.
+func (s *FakeServer) PushTxEvents(events ...apiv1.TxEvent) int {
+	s.mu.Lock()
+	lists := make(map[int64][]string)
+	for _, list := range s.lists {
+		lists[list.ID] = s.walletsInList(list.ID)
+	}
+	s.mu.Unlock()
+
+	sent := 0
+	for _, conn := range s.ws.snapshot() {
+		for _, event := range events {
+			if !conn.subscribed(event, lists) {
+				continue
+			}
+
+			data, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+
+			if conn.write(map[string]any{"type": apiv1.TxEventType, "data": json.RawMessage(data)}) == nil {
+				sent++
+			}
+		}
+	}
+
+	return sent
+}
+
+// CloseWebsockets closes every open WebSocket connection with the close code and reason,
+// e.g. websocket.CloseGoingAway to simulate a server restart.
+func (s *FakeServer) CloseWebsockets(code int, reason string) {
+	for _, conn := range s.ws.snapshot() {
+		conn.mu.Lock()
+		_ = conn.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+		conn.mu.Unlock()
+		_ = conn.conn.Close()
+	}
+}
+
+func (s *FakeServer) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{}
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	conn := &fakeWebsocketConn{conn: ws, wallets: make(map[string]*apiv1.Filter)}
+	s.ws.add(conn)
+	defer func() {
+		s.ws.remove(conn)
+		_ = ws.Close()
+	}()
+
+	for {
+		_, message, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var cmd fakeCommand
+		if err := json.Unmarshal(message, &cmd); err != nil {
+			_ = conn.write(map[string]any{"type": apiv1.ErrEventType, "data": "invalid command"})
+			continue
+		}
+
+		_ = conn.handle(cmd)
+	}
+}
+
+// handle applies a command and acknowledges it.
+func (c *fakeWebsocketConn) handle(cmd fakeCommand) error {
+	c.mu.Lock()
+	var ack map[string]any
+	switch cmd.Type {
+	case apiv1.WalletSubscribeCommandType:
+		c.wallets[cmd.Wallet] = cmd.Filter
+		ack = map[string]any{"type": apiv1.WalletSubscribedEventType, "data": map[string]any{"wallet": cmd.Wallet, "filter": cmd.Filter}}
+	case apiv1.WalletUnsubscribeCommandType:
+		delete(c.wallets, cmd.Wallet)
+		ack = map[string]any{"type": apiv1.WalletUnsubscribedEventType, "data": map[string]any{"wallet": cmd.Wallet}}
+	case apiv1.FeedSubscribeCommandType:
+		c.feed = &fakeFeedSubscription{listID: cmd.ListID, filter: cmd.Filter}
+		ack = map[string]any{"type": apiv1.FeedSubscribedEventType, "data": map[string]any{"list_id": cmd.ListID, "filter": cmd.Filter}}
+	case apiv1.FeedUnsubscribeCommandType:
+		c.feed = nil
+		ack = map[string]any{"type": apiv1.FeedUnsubscribedEventType, "data": map[string]any{}}
+	default:
+		ack = map[string]any{"type": apiv1.ErrEventType, "data": "unknown command type: " + string(cmd.Type)}
+	}
+	c.mu.Unlock()
+
+	return c.write(ack)
+}
+
+// subscribed reports whether the connection subscribed to the event.
+func (c *fakeWebsocketConn) subscribed(event apiv1.TxEvent, lists map[int64][]string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if filter, ok := c.wallets[event.Wallet]; ok && matchFilter(filter, event) {
+		return true
+	}
+
+	if c.feed == nil || !matchFilter(c.feed.filter, event) {
+		return false
+	}
+
+	return c.feed.listID == nil || slices.Contains(lists[*c.feed.listID], event.Wallet)
+}
+
+func (c *fakeWebsocketConn) write(v any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.conn.WriteJSON(v)
+}
+
+func matchFilter(filter *apiv1.Filter, event apiv1.TxEvent) bool {
+	if filter == nil {
+		return true
+	}
+
+	switch {
+	case len(filter.TxTypes) > 0 && !slices.Contains(filter.TxTypes, event.TxType),
+		len(filter.Chains) > 0 && !slices.Contains(filter.Chains, string(event.Chain)):
+		return false
+	default:
+		return true
+	}
+}
+
+func (ws *fakeWebsockets) add(conn *fakeWebsocketConn) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	ws.conns[conn] = struct{}{}
+}
+
+func (ws *fakeWebsockets) remove(conn *fakeWebsocketConn) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	delete(ws.conns, conn)
+}
+
+func (ws *fakeWebsockets) snapshot() []*fakeWebsocketConn {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	conns := make([]*fakeWebsocketConn, 0, len(ws.conns))
+	for conn := range ws.conns {
+		conns = append(conns, conn)
+	}
+
+	return conns
+}
+
+func (ws *fakeWebsockets) closeAll() {
+	for _, conn := range ws.snapshot() {
+		_ = conn.conn.Close()
+	}
+}
@@ -0,0 +1,359 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// RecorderMode selects whether a Recorder records or replays interactions.
+type RecorderMode int
+
+const (
+	// ModeReplay serves responses from the cassette and fails requests that match no recorded interaction.
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends requests to the real transport and saves the interactions to the cassette
+	// when the test ends.
+	ModeRecord
+)
+
+// MatchField is a part of the request compared when looking for a recorded interaction.
+type MatchField int
+
+const (
+	MatchMethod MatchField = iota
+	MatchPath
+	MatchQuery
+	MatchBody
+)
+
+// scrubbed replaces secrets in cassettes.
+const scrubbed = "[REDACTED]"
+
+// Cassette is the file format of recorded interactions.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a recorded HTTP request.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a recorded HTTP response.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Matcher reports whether a recorded request matches an incoming request with the given body.
+type Matcher func(r *http.Request, body []byte, recorded RecordedRequest) bool
+
+// RecorderOption configures a Recorder.
+type RecorderOption func(*Recorder)
+
+// WithRecorderTransport sets the transport used in record mode. Defaults to http.DefaultTransport.
+func WithRecorderTransport(rt http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = rt
+	}
+}
+
+// WithMatchFields sets the request parts compared in replay mode. Defaults to method, path and query.
+func WithMatchFields(fields ...MatchField) RecorderOption {
+	return func(r *Recorder) {
+		r.matcher = fieldMatcher(fields)
+	}
+}
+
+// WithMatcher sets a custom matcher, replacing WithMatchFields.
+func WithMatcher(m Matcher) RecorderOption {
+	return func(r *Recorder) {
+		r.matcher = m
+	}
+}
+
+// WithScrubbedHeaders removes additional request headers from the cassette. X-Api-Key is always scrubbed.
+func WithScrubbedHeaders(names ...string) RecorderOption {
+	return func(r *Recorder) {
+		for _, name := range names {
+			r.scrubHeaders = append(r.scrubHeaders, http.CanonicalHeaderKey(name))
+		}
+	}
+}
+
+// Recorder is an http.RoundTripper recording interactions to a cassette file or replaying them.
+// The API key is scrubbed from recorded headers, URLs and bodies.
+//
+//	rec := testutil.NewRecorder(t, "testdata/cassettes/portfolio.json", testutil.ModeReplay)
+//	client := cielogo.NewClient("test-key", cielogo.WithHTTPClient(rec.Client()))
+type Recorder struct {
+	mode         RecorderMode
+	path         string
+	transport    http.RoundTripper
+	matcher      Matcher
+	scrubHeaders []string
+
+	mu           sync.Mutex
+	interactions []Interaction
+	played       []bool
+	secrets      []string
+}
+
+// NewRecorder returns a recorder for the cassette file. In replay mode the cassette is loaded
+// immediately and the test fails if it cannot be read; in record mode it is written when the test ends.
+func NewRecorder(t testing.TB, path string, mode RecorderMode, opts ...RecorderOption) *Recorder {
+	t.Helper()
+
+	r := &Recorder{
+		mode:         mode,
+		path:         path,
+		transport:    http.DefaultTransport,
+		matcher:      fieldMatcher([]MatchField{MatchMethod, MatchPath, MatchQuery}),
+		scrubHeaders: []string{"X-Api-Key"},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	switch mode {
+	case ModeReplay:
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			t.Fatalf("testutil: %v", err)
+		}
+		r.interactions = cassette.Interactions
+		r.played = make([]bool, len(cassette.Interactions))
+	case ModeRecord:
+		t.Cleanup(func() {
+			if err := r.save(); err != nil {
+				t.Errorf("testutil: %v", err)
+			}
+		})
+	}
+
+	return r
+}
+
+// LoadCassette reads a cassette file.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("failed to decode cassette %s: %w", path, err)
+	}
+
+	return &cassette, nil
+}
+
+// Client returns an HTTP client using the recorder as its transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Unplayed returns the recorded interactions that were not replayed yet.
+func (r *Recorder) Unplayed() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unplayed []Interaction
+	for i, played := range r.played {
+		if !played {
+			unplayed = append(unplayed, r.interactions[i])
+		}
+	}
+
+	return unplayed
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("testutil: failed to read request body: %w", err)
+		}
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+
+	return r.replay(req, body)
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.played[i] || !r.matcher(req, body, interaction.Request) {
+			continue
+		}
+
+		r.played[i] = true
+		recorded := interaction.Response
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("testutil: no recorded interaction in %s matches %s %s", r.path, req.Method, req.URL.RequestURI())
+}
+
+// record sends a copy of req, with the already read body, and records the interaction.
+// req itself is left untouched, as required by the http.RoundTripper contract.
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	if body != nil {
+		out.Body = io.NopCloser(bytes.NewReader(body))
+		out.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	resp, err := r.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	resp.Request = req
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("testutil: failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	header := req.Header.Clone()
+	for _, name := range r.scrubHeaders {
+		for _, value := range header.Values(name) {
+			r.secrets = append(r.secrets, value)
+		}
+		if header.Get(name) != "" {
+			header.Set(name, scrubbed)
+		}
+	}
+
+	r.interactions = append(r.interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: header,
+			Body:   string(body),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       string(respBody),
+		},
+	})
+
+	return resp, nil
+}
+
+// save writes the recorded interactions to the cassette, scrubbing every secret seen in headers.
+func (r *Recorder) save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(Cassette{Interactions: r.interactions}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+
+	for _, secret := range r.secrets {
+		if secret != "" {
+			data = bytes.ReplaceAll(data, []byte(secret), []byte(scrubbed))
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+
+	if err := os.WriteFile(r.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	return nil
+}
+
+// fieldMatcher matches requests on the given fields.
+func fieldMatcher(fields []MatchField) Matcher {
+	return func(r *http.Request, body []byte, recorded RecordedRequest) bool {
+		u, err := url.Parse(recorded.URL)
+		if err != nil {
+			return false
+		}
+
+		for _, field := range fields {
+			var ok bool
+			switch field {
+			case MatchMethod:
+				ok = r.Method == recorded.Method
+			case MatchPath:
+				ok = r.URL.Path == u.Path
+			case MatchQuery:
+				ok = reflect.DeepEqual(r.URL.Query(), u.Query())
+			case MatchBody:
+				ok = equalBodies(body, []byte(recorded.Body))
+			}
+
+			if !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// equalBodies compares bodies as JSON values when both are JSON, byte by byte otherwise.
+func equalBodies(a, b []byte) bool {
+	var va, vb any
+	if errors.Join(json.Unmarshal(a, &va), json.Unmarshal(b, &vb)) == nil {
+		return reflect.DeepEqual(va, vb)
+	}
+
+	return bytes.Equal(bytes.TrimSpace(a), bytes.TrimSpace(b))
+}
@@ -0,0 +1,153 @@
+package testutil_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const recorderKey = "super-secret-key"
+
+// recordCassette records a tracked-wallet scenario against a FakeServer, stops the server and
+// returns its URL and the cassette path.
+func recordCassette(t *testing.T) (string, string) {
+	t.Helper()
+
+	cassette := filepath.Join(t.TempDir(), "cassettes", "tracked.json")
+	var baseURL string
+
+	t.Run("record", func(t *testing.T) {
+		server := testutil.NewFakeServer(t)
+		baseURL = server.URL
+
+		rec := testutil.NewRecorder(t, cassette, testutil.ModeRecord)
+		client := cielogo.NewClient(recorderKey, cielogo.WithBaseURL(server.URL), cielogo.WithHTTPClient(rec.Client()))
+
+		_, err := client.AddTrackedWalletsV1(context.Background(), &apiv1.AddTrackedWalletRequest{Wallet: "0xabc", Label: "whale"})
+		require.NoError(t, err)
+		tracked, err := client.GetTrackedWalletsV1(context.Background(), &apiv1.GetTrackedWalletsRequest{})
+		require.NoError(t, err)
+		require.Len(t, tracked.TrackedWallets, 1)
+	})
+
+	return baseURL, cassette
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	baseURL, cassette := recordCassette(t)
+
+	data, err := os.ReadFile(cassette)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), recorderKey)
+	assert.Contains(t, string(data), "[REDACTED]")
+
+	loaded, err := testutil.LoadCassette(cassette)
+	require.NoError(t, err)
+	require.Len(t, loaded.Interactions, 2)
+	assert.Equal(t, http.MethodPost, loaded.Interactions[0].Request.Method)
+	assert.Equal(t, http.StatusOK, loaded.Interactions[1].Response.StatusCode)
+
+	// The server is gone; responses come from the cassette.
+	rec := testutil.NewRecorder(t, cassette, testutil.ModeReplay)
+	client := cielogo.NewClient("other-key", cielogo.WithBaseURL(baseURL), cielogo.WithHTTPClient(rec.Client()))
+
+	added, err := client.AddTrackedWalletsV1(context.Background(), &apiv1.AddTrackedWalletRequest{Wallet: "0xabc", Label: "whale"})
+	require.NoError(t, err)
+	assert.Equal(t, "whale", added.Label)
+
+	tracked, err := client.GetTrackedWalletsV1(context.Background(), &apiv1.GetTrackedWalletsRequest{})
+	require.NoError(t, err)
+	require.Len(t, tracked.TrackedWallets, 1)
+	assert.Equal(t, "0xabc", tracked.TrackedWallets[0].Wallet)
+	assert.Empty(t, rec.Unplayed())
+
+	// Every interaction is replayed once.
+	_, err = client.GetTrackedWalletsV1(context.Background(), &apiv1.GetTrackedWalletsRequest{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no recorded interaction")
+}
+
+func TestRecorder_Matching(t *testing.T) {
+	baseURL, cassette := recordCassette(t)
+	ctx := context.Background()
+
+	t.Run("unmatched path", func(t *testing.T) {
+		rec := testutil.NewRecorder(t, cassette, testutil.ModeReplay)
+		client := cielogo.NewClient("key", cielogo.WithBaseURL(baseURL), cielogo.WithHTTPClient(rec.Client()))
+
+		_, err := client.GetUserWalletsListsV1(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "GET /v1/lists")
+		assert.Len(t, rec.Unplayed(), 2)
+	})
+
+	t.Run("body ignored by default", func(t *testing.T) {
+		rec := testutil.NewRecorder(t, cassette, testutil.ModeReplay)
+		client := cielogo.NewClient("key", cielogo.WithBaseURL(baseURL), cielogo.WithHTTPClient(rec.Client()))
+
+		_, err := client.AddTrackedWalletsV1(ctx, &apiv1.AddTrackedWalletRequest{Wallet: "0xdef", Label: "other"})
+		require.NoError(t, err)
+	})
+
+	t.Run("body matching", func(t *testing.T) {
+		rec := testutil.NewRecorder(t, cassette, testutil.ModeReplay,
+			testutil.WithMatchFields(testutil.MatchMethod, testutil.MatchPath, testutil.MatchBody))
+		client := cielogo.NewClient("key", cielogo.WithBaseURL(baseURL), cielogo.WithHTTPClient(rec.Client()))
+
+		_, err := client.AddTrackedWalletsV1(ctx, &apiv1.AddTrackedWalletRequest{Wallet: "0xdef", Label: "other"})
+		require.Error(t, err)
+
+		_, err = client.AddTrackedWalletsV1(ctx, &apiv1.AddTrackedWalletRequest{Wallet: "0xabc", Label: "whale"})
+		require.NoError(t, err)
+	})
+
+	t.Run("custom matcher", func(t *testing.T) {
+		rec := testutil.NewRecorder(t, cassette, testutil.ModeReplay,
+			testutil.WithMatcher(func(r *http.Request, _ []byte, recorded testutil.RecordedRequest) bool {
+				return r.Method == recorded.Method
+			}))
+		client := cielogo.NewClient("key", cielogo.WithBaseURL(baseURL), cielogo.WithHTTPClient(rec.Client()))
+
+		_, err := client.GetTrackedWalletsV1(ctx, &apiv1.GetTrackedWalletsRequest{ListID: testutil.Ptr(int64(42))})
+		require.NoError(t, err)
+	})
+}
+
+type closeTracker struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeTracker) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestRecorder_DoesNotModifyRequest(t *testing.T) {
+	server := testutil.NewFakeServer(t)
+	rec := testutil.NewRecorder(t, filepath.Join(t.TempDir(), "tracked.json"), testutil.ModeRecord)
+
+	body := &closeTracker{Reader: strings.NewReader(`{"wallet":"0xabc","label":"whale"}`)}
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/v1/tracked-wallets", body)
+	require.NoError(t, err)
+	req.Header.Set("X-Api-Key", recorderKey)
+
+	resp, err := rec.RoundTrip(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Same(t, req, resp.Request)
+	assert.Same(t, body, req.Body, "the request body must not be replaced")
+	assert.True(t, body.closed)
+}
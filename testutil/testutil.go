@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"cmp"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -14,6 +15,17 @@ type MockServer struct {
 	Responses    map[string]interface{}
 	RequestCount map[string]int
 	mu           sync.RWMutex
+
+	replies  map[string][]MockReply
+	hold     chan struct{}
+	canceled int
+}
+
+// MockReply is a scripted reply with an explicit status code, headers and raw body
+type MockReply struct {
+	Status int // defaults to 200
+	Header http.Header
+	Body   string
 }
 
 // NewMockServer creates a new mock Cielo API server
@@ -21,6 +33,7 @@ func NewMockServer(t *testing.T) *MockServer {
 	ms := &MockServer{
 		Responses:    make(map[string]interface{}),
 		RequestCount: make(map[string]int),
+		replies:      make(map[string][]MockReply),
 	}
 
 	ms.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -31,8 +44,30 @@ func NewMockServer(t *testing.T) *MockServer {
 
 		ms.mu.Lock()
 		ms.RequestCount[path]++
+		reply, scripted := ms.nextReply(path)
+		hold := ms.hold
 		ms.mu.Unlock()
 
+		if hold != nil {
+			select {
+			case <-hold:
+			case <-r.Context().Done():
+				ms.mu.Lock()
+				ms.canceled++
+				ms.mu.Unlock()
+				return
+			}
+		}
+
+		if scripted {
+			for k, v := range reply.Header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(cmp.Or(reply.Status, http.StatusOK))
+			_, _ = w.Write([]byte(reply.Body))
+			return
+		}
+
 		ms.mu.RLock()
 		response, ok := ms.Responses[path]
 		ms.mu.RUnlock()
@@ -40,7 +75,8 @@ func NewMockServer(t *testing.T) *MockServer {
 		if !ok {
 			w.WriteHeader(http.StatusNotFound)
 			if err := json.NewEncoder(w).Encode(map[string]string{
-				"error": "not found",
+				"status":  "error",
+				"message": "not found",
 			}); err != nil {
 				t.Logf("Failed to encode 404 response: %v", err)
 			}
@@ -66,6 +102,44 @@ func (ms *MockServer) SetResponse(path string, response interface{}) {
 	ms.Responses[path] = response
 }
 
+// QueueReplies queues scripted replies for a path. They are served in order, one per request,
+// before falling back to the response configured with SetResponse
+func (ms *MockServer) QueueReplies(path string, replies ...MockReply) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.replies[path] = append(ms.replies[path], replies...)
+}
+
+func (ms *MockServer) nextReply(path string) (MockReply, bool) {
+	queue := ms.replies[path]
+	if len(queue) == 0 {
+		return MockReply{}, false
+	}
+	ms.replies[path] = queue[1:]
+	return queue[0], true
+}
+
+// Hold makes every request wait until the returned release function is called. A request whose
+// context ends while it is held gets no response and is counted by CanceledCount.
+// Requests still held when the test ends are released.
+func (ms *MockServer) Hold(t *testing.T) (release func()) {
+	hold := make(chan struct{})
+	release = sync.OnceFunc(func() { close(hold) })
+	t.Cleanup(release)
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.hold = hold
+	return release
+}
+
+// CanceledCount returns the number of held requests canceled by the client
+func (ms *MockServer) CanceledCount() int {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	return ms.canceled
+}
+
 // GetRequestCount returns the number of times a path was called
 func (ms *MockServer) GetRequestCount(path string) int {
 	ms.mu.RLock()
@@ -0,0 +1,92 @@
+package cielogo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sealtv/cielogo/api"
+)
+
+// Span names used by the client.
+const (
+	SpanWebsocketDial  = "cielo.websocket.dial"
+	SpanWebsocketSend  = "cielo.websocket.send"
+	SpanWebsocketEvent = "cielo.websocket.event"
+)
+
+// Attribute is a key-value pair attached to a span.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// Tracer starts spans around API calls and WebSocket operations.
+// It is a minimal abstraction meant to be adapted to a tracing library such as OpenTelemetry.
+type Tracer interface {
+	// Start starts a span as a child of the span carried by ctx, if any,
+	// and returns a context carrying the new span.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a single traced operation.
+type Span interface {
+	// SetAttributes adds attributes to the span.
+	SetAttributes(attrs ...Attribute)
+	// RecordError marks the span as failed.
+	RecordError(err error)
+	// End completes the span.
+	End()
+}
+
+// WithTracer traces every API call and WebSocket dial, command and received event.
+// API call spans are named "cielo.<Endpoint>", e.g. "cielo.GetFeedV1".
+func WithTracer(tracer Tracer) ClientOption {
+	return func(c *Client) {
+		if tracer != nil {
+			c.tracer = tracer
+		}
+	}
+}
+
+// traceCall runs the call within a span.
+func (c *Client) traceCall(ctx context.Context, call *Call, invoke Invoker) error {
+	ctx, span := c.tracer.Start(ctx, "cielo."+call.Endpoint,
+		Attribute{Key: "cielo.endpoint", Value: call.Endpoint},
+		Attribute{Key: "http.method", Value: call.Method},
+		Attribute{Key: "cielo.path", Value: call.Path},
+		Attribute{Key: "cielo.credits", Value: call.Credits},
+	)
+	defer span.End()
+
+	err := invoke(ctx, call)
+	endSpan(span, err)
+
+	return err
+}
+
+// endSpan records the outcome of an operation on span. Data that is not ready yet is not an error.
+func endSpan(span Span, err error) {
+	if err == nil {
+		return
+	}
+
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) {
+		span.SetAttributes(Attribute{Key: "http.status_code", Value: apiErr.StatusCode})
+	}
+	if !isNotReady(err) {
+		span.RecordError(err)
+	}
+}
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, _ string, _ ...Attribute) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(...Attribute) {}
+func (noopSpan) RecordError(error)          {}
+func (noopSpan) End()                       {}
@@ -0,0 +1,131 @@
+package cielogo_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type spanKey struct{}
+
+type recordedSpan struct {
+	name   string
+	parent string
+	attrs  map[string]any
+	err    error
+	ended  bool
+}
+
+func (s *recordedSpan) SetAttributes(attrs ...cielogo.Attribute) {
+	for _, attr := range attrs {
+		s.attrs[attr.Key] = attr.Value
+	}
+}
+
+func (s *recordedSpan) RecordError(err error) { s.err = err }
+func (s *recordedSpan) End()                  { s.ended = true }
+
+// recordingTracer records spans and links them to the span found in the parent context.
+type recordingTracer struct {
+	mu    sync.Mutex
+	spans []*recordedSpan
+}
+
+func (tr *recordingTracer) Start(ctx context.Context, name string, attrs ...cielogo.Attribute) (context.Context, cielogo.Span) {
+	span := &recordedSpan{name: name, attrs: make(map[string]any)}
+	if parent, ok := ctx.Value(spanKey{}).(*recordedSpan); ok {
+		span.parent = parent.name
+	}
+	span.SetAttributes(attrs...)
+
+	tr.mu.Lock()
+	tr.spans = append(tr.spans, span)
+	tr.mu.Unlock()
+
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func TestTracing_APICall(t *testing.T) {
+	server := newCachedServer(t)
+	tracer := &recordingTracer{}
+	var innerParent string
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithTracer(tracer),
+		cielogo.WithInterceptors(func(ctx context.Context, call *cielogo.Call, next cielogo.Invoker) error {
+			if span, ok := ctx.Value(spanKey{}).(*recordedSpan); ok {
+				innerParent = span.name
+			}
+			return next(ctx, call)
+		}),
+	)
+
+	parentCtx, parent := tracer.Start(context.Background(), "handler")
+	_, err := client.GetTokenPriceV1(parentCtx, priceRequest)
+	require.NoError(t, err)
+	_, err = client.GetTokenStatsV1(parentCtx, &apiv1.TokenStatsRequest{Chain: apiv1.TokenChainSolana, TokenAddress: "token"})
+	require.Error(t, err)
+	parent.End()
+
+	require.Len(t, tracer.spans, 3)
+	price := tracer.spans[1]
+	assert.Equal(t, "cielo.GetTokenPriceV1", price.name)
+	assert.Equal(t, "handler", price.parent)
+	assert.Equal(t, "cielo.GetTokenStatsV1", innerParent, "interceptors run inside the span")
+	assert.Equal(t, cielogo.EndpointGetTokenPriceV1, price.attrs["cielo.endpoint"])
+	assert.Equal(t, "GET", price.attrs["http.method"])
+	assert.Equal(t, priceQueryPath, price.attrs["cielo.path"])
+	assert.True(t, price.ended)
+	assert.NoError(t, price.err)
+
+	stats := tracer.spans[2]
+	assert.Equal(t, "handler", stats.parent)
+	assert.Error(t, stats.err)
+	assert.Equal(t, 404, stats.attrs["http.status_code"])
+	assert.True(t, stats.ended)
+}
+
+func TestTracing_Websocket(t *testing.T) {
+	url := newScriptedWebsocketServer(t,
+		`{"type":"feed_subscribed","data":{}}`,
+		`not json`,
+		`{"type":"tx","data":{"tx_type":"swap","chain":"ethereum","wallet":"0x1"}}`,
+	)
+	tracer := &recordingTracer{}
+	client := cielogo.NewClient("test-key", cielogo.WithWebsocketURL(url), cielogo.WithTracer(tracer))
+
+	ctx, parent := tracer.Start(context.Background(), "worker")
+	ws, err := client.NewWebsocketConnection(ctx)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	require.NoError(t, ws.SendCommandContext(ctx, &apiv1.FeedSubscribeCmd{}))
+
+	events := make(chan apiv1.WSEvent, 10)
+	require.NoError(t, ws.RunListener(ctx, events))
+	parent.End()
+
+	var names []string
+	for _, span := range tracer.spans[1:] {
+		names = append(names, span.name)
+		assert.Equal(t, "worker", span.parent)
+		assert.True(t, span.ended)
+	}
+	assert.Equal(t, []string{
+		cielogo.SpanWebsocketDial,
+		cielogo.SpanWebsocketSend,
+		cielogo.SpanWebsocketEvent,
+		cielogo.SpanWebsocketEvent,
+		cielogo.SpanWebsocketEvent,
+	}, names)
+
+	assert.Equal(t, "subscribe_feed", tracer.spans[2].attrs["cielo.command"])
+	assert.Equal(t, "feed_subscribed", tracer.spans[3].attrs["cielo.event_type"])
+	assert.Error(t, tracer.spans[4].err)
+	assert.Equal(t, "swap", tracer.spans[5].attrs["cielo.tx_type"])
+}
@@ -0,0 +1,153 @@
+package cielogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+)
+
+const (
+	defaultTradingStatsPollInterval = 10 * time.Second
+	defaultTradingStatsMaxWait      = 2 * time.Minute
+)
+
+// WithTradingStatsPolling configures how GetTradingStatsV1 waits for stats that are still being computed.
+// interval is the delay between polls (a Retry-After header sent by the API takes precedence),
+// maxWait bounds the total time spent waiting. A maxWait of zero disables polling.
+func WithTradingStatsPolling(interval, maxWait time.Duration) ClientOption {
+	return func(c *Client) {
+		c.statsPollInterval = interval
+		c.statsMaxWait = maxWait
+	}
+}
+
+// PendingTradingStats is a handle to trading stats that may still be computed by the API.
+// It is safe for concurrent use.
+type PendingTradingStats struct {
+	fetch     func(ctx context.Context) (*apiv1.TradingStatsResponse, error)
+	interval  time.Duration
+	maxWait   time.Duration
+	startedAt time.Time
+
+	mu       sync.Mutex
+	result   *apiv1.TradingStatsResponse
+	nextPoll time.Time
+}
+
+// StartTradingStatsV1 requests trading stats without waiting for them to be computed.
+// The returned handle either holds the stats already or can be checked later with Check or Wait.
+//
+// Cost: 30 credits per request (each poll is a separate request)
+func (c *Client) StartTradingStatsV1(ctx context.Context, req *apiv1.TradingStatsRequest) (*PendingTradingStats, error) {
+	fetch := func(ctx context.Context) (*apiv1.TradingStatsResponse, error) {
+		return c.fetchTradingStatsV1(ctx, req)
+	}
+
+	return StartTradingStats(ctx, fetch, c.statsPollInterval, c.statsMaxWait)
+}
+
+// StartTradingStats returns a handle to trading stats fetched with fetch, which must return an
+// error matching api.ErrDataNotReady while the stats are being computed. Check calls fetch at most
+// once per interval (a Retry-After header of an *api.Error takes precedence) and Wait gives up
+// after maxWait. It lets other implementations of PnLAPI, such as fakes, return the same handle
+// as Client.StartTradingStatsV1.
+func StartTradingStats(ctx context.Context, fetch func(ctx context.Context) (*apiv1.TradingStatsResponse, error), interval, maxWait time.Duration) (*PendingTradingStats, error) {
+	p := &PendingTradingStats{
+		fetch:     fetch,
+		interval:  interval,
+		maxWait:   maxWait,
+		startedAt: time.Now(),
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, _, err := p.poll(ctx); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Check returns the stats if they are ready. While they are not, it queries the API at most once
+// per poll interval; calls made before NextPoll return immediately without spending credits.
+func (p *PendingTradingStats) Check(ctx context.Context) (*apiv1.TradingStatsResponse, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.result != nil {
+		return p.result, true, nil
+	}
+
+	if time.Now().Before(p.nextPoll) {
+		return nil, false, nil
+	}
+
+	return p.poll(ctx)
+}
+
+// NextPoll returns the earliest time at which Check queries the API again.
+func (p *PendingTradingStats) NextPoll() time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.nextPoll
+}
+
+// Wait blocks until the stats are ready, the context is done or the maximum wait is exceeded.
+// In the latter case the returned error matches api.ErrDataNotReady.
+func (p *PendingTradingStats) Wait(ctx context.Context) (*apiv1.TradingStatsResponse, error) {
+	deadline := p.startedAt.Add(p.maxWait)
+
+	for {
+		stats, ready, err := p.Check(ctx)
+		if err != nil || ready {
+			return stats, err
+		}
+
+		next := p.NextPoll()
+		if next.After(deadline) {
+			return nil, fmt.Errorf("failed to get trading stats: not ready after %s: %w", p.maxWait, api.ErrDataNotReady)
+		}
+
+		if err := sleepContext(ctx, time.Until(next)); err != nil {
+			return nil, fmt.Errorf("failed to get trading stats: %w", err)
+		}
+	}
+}
+
+// isNotReady reports whether err is the 202 Accepted answer of an endpoint still computing its
+// data. It is the expected outcome of a poll, so it is not logged, counted or traced as a failure.
+func isNotReady(err error) bool {
+	return errors.Is(err, api.ErrDataNotReady)
+}
+
+// poll queries the API once. It must be called with p.mu held.
+func (p *PendingTradingStats) poll(ctx context.Context) (*apiv1.TradingStatsResponse, bool, error) {
+	stats, err := p.fetch(ctx)
+	if err == nil {
+		p.result = stats
+		return stats, true, nil
+	}
+
+	if !isNotReady(err) {
+		return nil, false, err
+	}
+
+	delay := p.interval
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) {
+		if retryAfter, ok := parseRetryAfter(apiErr.Header.Get("Retry-After"), time.Now()); ok {
+			delay = retryAfter
+		}
+	}
+
+	p.nextPoll = time.Now().Add(delay)
+
+	return nil, false, nil
+}
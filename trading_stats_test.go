@@ -0,0 +1,146 @@
+package cielogo_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tradingStatsPath = "/v1/wallet123/trading-stats"
+
+// newPendingStatsServer returns a server that answers 202 Accepted `pending` times before returning stats.
+func newPendingStatsServer(t *testing.T, pending int) *testutil.MockServer {
+	t.Helper()
+
+	server := testutil.NewMockServer(t)
+	for range pending {
+		server.QueueReplies(tradingStatsPath, testutil.MockReply{
+			Status: http.StatusAccepted,
+			Header: http.Header{"Content-Type": {"application/json"}},
+			Body:   `{"status":"pending","message":"data is being calculated"}`,
+		})
+	}
+	server.SetResponse(tradingStatsPath, api.CieloResponse[apiv1.TradingStatsResponse]{
+		Status: "ok",
+		Data:   apiv1.TradingStatsResponse{TotalTrades: 42, WinRate: 0.5},
+	})
+
+	return server
+}
+
+func TestGetTradingStatsV1_PollsUntilReady(t *testing.T) {
+	server := newPendingStatsServer(t, 2)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithTradingStatsPolling(5*time.Millisecond, time.Second),
+	)
+
+	stats, err := client.GetTradingStatsV1(context.Background(), &apiv1.TradingStatsRequest{Wallet: "wallet123"})
+	require.NoError(t, err)
+	assert.Equal(t, 42, stats.TotalTrades)
+	server.AssertRequestCount(t, tradingStatsPath, 3)
+}
+
+func TestGetTradingStatsV1_MaxWaitExceeded(t *testing.T) {
+	server := newPendingStatsServer(t, 100)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithTradingStatsPolling(20*time.Millisecond, 50*time.Millisecond),
+	)
+
+	_, err := client.GetTradingStatsV1(context.Background(), &apiv1.TradingStatsRequest{Wallet: "wallet123"})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, api.ErrDataNotReady)
+}
+
+func TestGetTradingStatsV1_PollingDisabled(t *testing.T) {
+	server := newPendingStatsServer(t, 1)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithTradingStatsPolling(time.Millisecond, 0),
+	)
+
+	_, err := client.GetTradingStatsV1(context.Background(), &apiv1.TradingStatsRequest{Wallet: "wallet123"})
+	assert.ErrorIs(t, err, api.ErrDataNotReady)
+	server.AssertRequestCount(t, tradingStatsPath, 1)
+}
+
+func TestGetTradingStatsV1_ContextCanceledWhileWaiting(t *testing.T) {
+	server := newPendingStatsServer(t, 100)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithTradingStatsPolling(time.Second, time.Minute),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetTradingStatsV1(ctx, &apiv1.TradingStatsRequest{Wallet: "wallet123"})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestStartTradingStatsV1_CheckRespectsInterval(t *testing.T) {
+	server := newPendingStatsServer(t, 1)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithTradingStatsPolling(50*time.Millisecond, time.Second),
+	)
+	ctx := context.Background()
+
+	pending, err := client.StartTradingStatsV1(ctx, &apiv1.TradingStatsRequest{Wallet: "wallet123"})
+	require.NoError(t, err)
+
+	stats, ready, err := pending.Check(ctx)
+	require.NoError(t, err)
+	assert.False(t, ready)
+	assert.Nil(t, stats)
+	// A check before the poll interval must not hit the API.
+	server.AssertRequestCount(t, tradingStatsPath, 1)
+
+	time.Sleep(time.Until(pending.NextPoll()))
+
+	stats, ready, err = pending.Check(ctx)
+	require.NoError(t, err)
+	assert.True(t, ready)
+	assert.Equal(t, 42, stats.TotalTrades)
+	server.AssertRequestCount(t, tradingStatsPath, 2)
+}
+
+func TestGetTradingStatsV1_PendingPollsAreNotFailures(t *testing.T) {
+	server := newPendingStatsServer(t, 1)
+	logger, logs := newTestLogger(slog.LevelDebug)
+	tracer := &recordingTracer{}
+	client := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithTradingStatsPolling(5*time.Millisecond, time.Second),
+		cielogo.WithLogger(logger),
+		cielogo.WithTracer(tracer),
+	)
+
+	_, err := client.GetTradingStatsV1(context.Background(), &apiv1.TradingStatsRequest{Wallet: "wallet123"})
+	require.NoError(t, err)
+
+	records := logs.records(t)
+	require.Len(t, records, 2)
+	assert.Equal(t, "DEBUG", records[0]["level"])
+	assert.Equal(t, float64(http.StatusAccepted), records[0]["status"])
+	assert.Equal(t, true, records[0]["data_not_ready"])
+	assert.NotContains(t, records[0], "error")
+
+	out := scrapeMetrics(t, client)
+	assert.Contains(t, out, `cielo_requests_total{endpoint="GetTradingStatsV1"} 2`+"\n")
+	assert.NotContains(t, out, `cielo_request_errors_total{endpoint="GetTradingStatsV1"`)
+
+	require.Len(t, tracer.spans, 2)
+	assert.NoError(t, tracer.spans[0].err)
+	assert.Equal(t, http.StatusAccepted, tracer.spans[0].attrs["http.status_code"])
+}
@@ -0,0 +1,60 @@
+package cielogo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+)
+
+// WithStrictTxTypes makes feed pages and WebSocket messages holding a transaction of a type this
+// version of the library does not know fail to decode with apiv1.ErrUnknownTxType. By default such
+// transactions are decoded into an *apiv1.UnknownEvent.
+func WithStrictTxTypes() ClientOption {
+	return func(c *Client) {
+		c.txDecoding.StrictTypes = true
+	}
+}
+
+// WithUnknownTxTypeHandler sets a function called with every transaction of an unknown type
+// decoded from a feed page or a WebSocket message, e.g. to log or count them.
+// It is called in both lenient and strict mode and may be called concurrently.
+func WithUnknownTxTypeHandler(fn func(*apiv1.UnknownEvent)) ClientOption {
+	return func(c *Client) {
+		c.txDecoding.OnUnknownType = fn
+	}
+}
+
+// WithRawTxEvents keeps the JSON object of every transaction decoded from a feed page or a
+// WebSocket message in TxEvent.Raw.
+func WithRawTxEvents() ClientOption {
+	return func(c *Client) {
+		c.txDecoding.KeepRaw = true
+	}
+}
+
+// decodeResult decodes a successful response body into out, decoding the transactions of
+// feed pages with the client's options.
+func (c *Client) decodeResult(body []byte, out any) error {
+	feed, ok := out.(*api.CieloResponse[apiv1.FeedResponse])
+	if !ok {
+		return decodeResponse(body, out)
+	}
+
+	resp := api.CieloResponse[json.RawMessage]{}
+	if err := decodeResponse(body, &resp); err != nil {
+		return err
+	}
+
+	feed.Status, feed.Message = resp.Status, resp.Message
+	if len(resp.Data) == 0 {
+		return nil
+	}
+
+	if err := c.txDecoding.UnmarshalFeedResponse(resp.Data, &feed.Data); err != nil {
+		return fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	return nil
+}
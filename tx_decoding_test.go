@@ -0,0 +1,97 @@
+package cielogo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/api/chains"
+	"github.com/sealtv/cielogo/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var restakeEvent = apiv1.TxEvent{
+	Wallet:    "0x1",
+	TxType:    "restake",
+	Chain:     chains.Ethereum,
+	Timestamp: 1700000000,
+	Data:      &apiv1.UnknownEvent{Raw: []byte(`{"tx_type":"restake","operator":"0xop"}`)},
+}
+
+func TestClient_UnknownTxTypes(t *testing.T) {
+	server := testutil.NewFakeServer(t)
+	server.AddFeedEvents(restakeEvent)
+
+	var reported []apiv1.TxType
+	lenient := cielogo.NewClient("test-key",
+		cielogo.WithBaseURL(server.URL),
+		cielogo.WithUnknownTxTypeHandler(func(e *apiv1.UnknownEvent) { reported = append(reported, e.TxType) }),
+	)
+
+	feed, err := lenient.GetFeedV1(context.Background(), &apiv1.FeedRequest{})
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	assert.IsType(t, &apiv1.UnknownEvent{}, feed.Items[0].Data)
+	assert.Equal(t, []apiv1.TxType{"restake"}, reported)
+
+	strict := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL), cielogo.WithStrictTxTypes())
+
+	_, err = strict.GetFeedV1(context.Background(), &apiv1.FeedRequest{})
+	require.ErrorIs(t, err, apiv1.ErrUnknownTxType)
+
+	_, err = cielogo.FeedAll(context.Background(), strict, &apiv1.FeedRequest{}).Collect()
+	require.ErrorIs(t, err, apiv1.ErrUnknownTxType)
+
+	assert.Equal(t, []apiv1.TxType{"restake"}, reported, "options are per client")
+}
+
+func TestWebsocketClient_UnknownTxTypes(t *testing.T) {
+	server := testutil.NewFakeServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	reported := make(chan *apiv1.UnknownEvent, 1)
+	client := cielogo.NewClient("test-key",
+		cielogo.WithWebsocketURL(server.WebsocketURL()),
+		cielogo.WithUnknownTxTypeHandler(func(e *apiv1.UnknownEvent) { reported <- e }),
+	)
+
+	ws, err := client.NewWebsocketConnection(ctx)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	events := make(chan apiv1.WSEvent, 10)
+	go func() { _ = ws.RunListener(ctx, events) }()
+
+	require.NoError(t, ws.SendCommand(&apiv1.WalletSubscribeCmd{Wallet: "0x1"}))
+	<-events
+
+	require.Equal(t, 1, server.PushTxEvents(restakeEvent))
+
+	event := <-events
+	tx, ok := event.Data.(apiv1.TxEvent)
+	require.True(t, ok)
+	assert.IsType(t, &apiv1.UnknownEvent{}, tx.Data)
+	assert.Equal(t, apiv1.TxType("restake"), (<-reported).TxType)
+}
+
+func TestClient_WithRawTxEvents(t *testing.T) {
+	server := testutil.NewFakeServer(t)
+	server.AddFeedEvents(apiv1.TxEvent{Wallet: "0x1", TxType: apiv1.TxTypeSwap, Data: &apiv1.SwapEvent{TokenSymbol: "PEPE"}})
+
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL), cielogo.WithRawTxEvents())
+
+	feed, err := client.GetFeedV1(context.Background(), &apiv1.FeedRequest{})
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 1)
+	assert.Contains(t, string(feed.Items[0].Raw), `"token_symbol":"PEPE"`)
+
+	plain := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL))
+
+	feed, err = plain.GetFeedV1(context.Background(), &apiv1.FeedRequest{})
+	require.NoError(t, err)
+	assert.Nil(t, feed.Items[0].Raw)
+}
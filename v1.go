@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strconv"
 
 	"github.com/sealtv/cielogo/api"
 	"github.com/sealtv/cielogo/api/apiv1"
@@ -32,7 +31,14 @@ func (c *Client) GetFeedV1(ctx context.Context, req *apiv1.FeedRequest) (*apiv1.
 	resp := api.CieloResponse[apiv1.FeedResponse]{}
 
 	path := fmt.Sprintf("/v1/feed/?%s", req.GetQueryString())
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointGetFeedV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  feedCredits(req),
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get feed: %w", err)
 	}
 
@@ -47,8 +53,15 @@ func (c *Client) GetFeedV1(ctx context.Context, req *apiv1.FeedRequest) (*apiv1.
 func (c *Client) GetNftsPnlV1(ctx context.Context, req *apiv1.NftsPnLRequest) (*apiv1.NftsPnLResponse, error) {
 	resp := api.CieloResponse[apiv1.NftsPnLResponse]{}
 
-	path := fmt.Sprintf("/v1/%s/pnl/nfts?%s", req.Wallet, req.GetQueryString())
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	path := fmt.Sprintf("/v1/%s/pnl/nfts?%s", url.PathEscape(req.Wallet), req.GetQueryString())
+	call := &Call{
+		Endpoint: EndpointGetNftsPnlV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  5,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get  pnl: %w", err)
 	}
 
@@ -71,8 +84,15 @@ func (c *Client) GetNftsPnlV1(ctx context.Context, req *apiv1.NftsPnLRequest) (*
 func (c *Client) GetTokensPnlV1(ctx context.Context, req *apiv1.TokensPnLRequest) (*apiv1.TokensPnLResponse, error) {
 	resp := api.CieloResponse[apiv1.TokensPnLResponse]{}
 
-	path := fmt.Sprintf("/v1/%s/pnl/tokens?%s", req.Wallet, req.GetQueryString())
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	path := fmt.Sprintf("/v1/%s/pnl/tokens?%s", url.PathEscape(req.Wallet), req.GetQueryString())
+	call := &Call{
+		Endpoint: EndpointGetTokensPnlV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  5,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get tokens pnl: %w", err)
 	}
 
@@ -87,8 +107,15 @@ func (c *Client) GetTokensPnlV1(ctx context.Context, req *apiv1.TokensPnLRequest
 func (c *Client) GetAggregatedTokenPnLV1(ctx context.Context, req *apiv1.AggregatedTokenPnLRequest) (*apiv1.AggregatedTokenPnLResponse, error) {
 	resp := api.CieloResponse[apiv1.AggregatedTokenPnLResponse]{}
 
-	path := fmt.Sprintf("/v1/%s/pnl/total-stats?%s", req.Wallet, req.GetQueryString())
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	path := fmt.Sprintf("/v1/%s/pnl/total-stats?%s", url.PathEscape(req.Wallet), req.GetQueryString())
+	call := &Call{
+		Endpoint: EndpointGetAggregatedTokenPnLV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  20,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get tokens pnl: %w", err)
 	}
 
@@ -103,8 +130,15 @@ func (c *Client) GetAggregatedTokenPnLV1(ctx context.Context, req *apiv1.Aggrega
 func (c *Client) GetRelatedWalletsV1(ctx context.Context, req *apiv1.RelatedWalletsRequest) (*apiv1.RelatedWalletsResponse, error) {
 	resp := api.CieloResponse[apiv1.RelatedWalletsResponse]{}
 
-	path := fmt.Sprintf("/v1/%s/related-wallets/?%s", req.Wallet, req.GetQueryString())
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	path := fmt.Sprintf("/v1/%s/related-wallets/?%s", url.PathEscape(req.Wallet), req.GetQueryString())
+	call := &Call{
+		Endpoint: EndpointGetRelatedWalletsV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  10,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get tokens pnl: %w", err)
 	}
 
@@ -122,8 +156,15 @@ func (c *Client) GetRelatedWalletsV1(ctx context.Context, req *apiv1.RelatedWall
 func (c *Client) GetWalletTagsV1(ctx context.Context, req *apiv1.GetWalletTagsRequest) (*apiv1.GetWalletTagsResponse, error) {
 	resp := api.CieloResponse[apiv1.GetWalletTagsResponse]{}
 
-	path := fmt.Sprintf("/v1/%s/tags", req.Wallet)
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	path := fmt.Sprintf("/v1/%s/tags", url.PathEscape(req.Wallet))
+	call := &Call{
+		Endpoint: EndpointGetWalletTagsV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  5,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get wallet tags: %w", err)
 	}
 
@@ -138,14 +179,16 @@ func (c *Client) GetWalletTagsV1(ctx context.Context, req *apiv1.GetWalletTagsRe
 func (c *Client) GetWalletsTagsV1(ctx context.Context, req *apiv1.GetWalletsTagsRequest) ([]apiv1.WalletTags, error) {
 	resp := api.CieloResponse[[]apiv1.WalletTags]{}
 
-	values := url.Values{}
-	for _, wallet := range req.Wallets {
-		values.Add("wallet", wallet)
-	}
-
-	path := fmt.Sprintf("/v1/tags?%s", values.Encode())
+	path := fmt.Sprintf("/v1/tags?%s", req.GetQueryString())
 
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointGetWalletsTagsV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  5,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get wallets tags: %w", err)
 	}
 
@@ -160,26 +203,16 @@ func (c *Client) GetWalletsTagsV1(ctx context.Context, req *apiv1.GetWalletsTags
 func (c *Client) GetWalletsByTagV1(ctx context.Context, req *apiv1.GetWalletsByTagRequest) (*apiv1.GetWalletsByTagResponse, error) {
 	resp := api.CieloResponse[apiv1.GetWalletsByTagResponse]{}
 
-	values := url.Values{}
-	for _, tag := range req.Tags {
-		values.Add("tags", string(tag))
-	}
-
-	if req.WalletType != nil && *req.WalletType != "" {
-		values.Add("wallet_type", string(*req.WalletType))
-	}
+	path := fmt.Sprintf("/v1/tags/wallets?%s", req.GetQueryString())
 
-	if req.Limit != nil {
-		values.Add("limit", strconv.Itoa(*req.Limit))
+	call := &Call{
+		Endpoint: EndpointGetWalletsByTagV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  10,
 	}
-
-	if req.NextObject != nil && *req.NextObject != "" {
-		values.Add("next_object", *req.NextObject)
-	}
-
-	path := fmt.Sprintf("/v1/tags/wallets?%s", values.Encode())
-
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get wallets by tag: %w", err)
 	}
 
@@ -196,23 +229,16 @@ func (c *Client) GetWalletsByTagV1(ctx context.Context, req *apiv1.GetWalletsByT
 func (c *Client) GetAllWalletsListV1(ctx context.Context, req *apiv1.GetAllWalletsListsRequest) (*apiv1.GetAllWalletsListsResponse, error) {
 	resp := api.CieloResponse[apiv1.GetAllWalletsListsResponse]{}
 
-	values := url.Values{}
+	path := fmt.Sprintf("/v1/lists/all?%s", req.GetQueryString())
 
-	if req.Order != nil && *req.Order != "" {
-		values.Add("order", string(*req.Order))
+	call := &Call{
+		Endpoint: EndpointGetAllWalletsListV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  5,
 	}
-
-	if req.FollowOnly {
-		values.Add("follow_only", "true")
-	}
-
-	if req.NextObject != nil && *req.NextObject != "" {
-		values.Add("next_object", *req.NextObject)
-	}
-
-	path := fmt.Sprintf("/v1/lists/all?%s", values.Encode())
-
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get all wallets list: %w", err)
 	}
 
@@ -229,7 +255,13 @@ func (c *Client) GetUserWalletsListsV1(ctx context.Context) ([]apiv1.WalletList,
 
 	const path = "/v1/lists"
 
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointGetUserWalletsListsV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Credits:  5,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get users lists: %w", err)
 	}
 
@@ -246,7 +278,15 @@ func (c *Client) AddWalletsListV1(ctx context.Context, req *apiv1.AddWalletsList
 
 	resp := api.CieloResponse[apiv1.WalletList]{}
 
-	if err := c.makeRequest(ctx, http.MethodPost, path, req, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointAddWalletsListV1,
+		Method:   http.MethodPost,
+		Path:     path,
+		Request:  req,
+		Credits:  5,
+		body:     req,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to add wallet to list: %w", err)
 	}
 
@@ -262,7 +302,15 @@ func (c *Client) UpdateWalletsListV1(ctx context.Context, req *apiv1.UpdateWalle
 	resp := api.CieloResponse[apiv1.WalletList]{}
 	path := fmt.Sprintf("/v1/lists/%d", req.ListID)
 
-	if err := c.makeRequest(ctx, http.MethodPut, path, req, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointUpdateWalletsListV1,
+		Method:   http.MethodPut,
+		Path:     path,
+		Request:  req,
+		Credits:  5,
+		body:     req,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to update wallet list: %w", err)
 	}
 
@@ -284,7 +332,13 @@ func (c *Client) DeleteWalletsListV1(ctx context.Context, listID int64, deleteWa
 		path += "?delete_wallets=true"
 	}
 
-	if err := c.makeRequest(ctx, http.MethodDelete, path, nil, nil); err != nil {
+	call := &Call{
+		Endpoint: EndpointDeleteWalletsListV1,
+		Method:   http.MethodDelete,
+		Path:     path,
+		Credits:  5,
+	}
+	if err := c.makeRequest(ctx, call, nil); err != nil {
 		return fmt.Errorf("failed to delete wallet list: %w", err)
 	}
 
@@ -301,7 +355,13 @@ func (c *Client) ToggleFollowWalletsListV1(ctx context.Context, listID int64) (*
 
 	path := fmt.Sprintf("/v1/lists/%d/toggle-follow", listID)
 
-	if err := c.makeRequest(ctx, http.MethodPut, path, nil, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointToggleFollowWalletsListV1,
+		Method:   http.MethodPut,
+		Path:     path,
+		Credits:  5,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to toggle follow wallet list: %w", err)
 	}
 
@@ -314,20 +374,17 @@ func (c *Client) ToggleFollowWalletsListV1(ctx context.Context, listID int64) (*
 //
 // https://developer.cielo.finance/reference/gettrackedwallets
 func (c *Client) GetTrackedWalletsV1(ctx context.Context, req *apiv1.GetTrackedWalletsRequest) (*apiv1.GetTrackedWalletsResponse, error) {
-	const path = "/v1/tracked-wallets"
-
-	values := url.Values{}
-
-	if req.ListID != nil && *req.ListID != 0 {
-		values.Add("list_id", strconv.FormatInt(*req.ListID, 10))
-	}
-
-	if req.NextObject != nil && *req.NextObject != "" {
-		values.Add("next_object", *req.NextObject)
-	}
+	path := fmt.Sprintf("/v1/tracked-wallets?%s", req.GetQueryString())
 
 	resp := api.CieloResponse[apiv1.GetTrackedWalletsResponse]{}
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointGetTrackedWalletsV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  5,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get tracked wallets: %w", err)
 	}
 
@@ -343,7 +400,15 @@ func (c *Client) AddTrackedWalletsV1(ctx context.Context, req *apiv1.AddTrackedW
 	const path = "/v1/tracked-wallets"
 
 	resp := api.CieloResponse[apiv1.TrackedWallet]{}
-	if err := c.makeRequest(ctx, http.MethodPost, path, req, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointAddTrackedWalletsV1,
+		Method:   http.MethodPost,
+		Path:     path,
+		Request:  req,
+		Credits:  5,
+		body:     req,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to add tracked wallet: %w", err)
 	}
 
@@ -358,7 +423,15 @@ func (c *Client) AddTrackedWalletsV1(ctx context.Context, req *apiv1.AddTrackedW
 func (c *Client) RemoveTrackedWalletsV1(ctx context.Context, req *apiv1.RemoveTrackedWalletsRequest) error {
 	const path = "/v1/tracked-wallets"
 
-	if err := c.makeRequest(ctx, http.MethodDelete, path, req, nil); err != nil {
+	call := &Call{
+		Endpoint: EndpointRemoveTrackedWalletsV1,
+		Method:   http.MethodDelete,
+		Path:     path,
+		Request:  req,
+		Credits:  5,
+		body:     req,
+	}
+	if err := c.makeRequest(ctx, call, nil); err != nil {
 		return fmt.Errorf("failed to delete tracked wallet: %w", err)
 	}
 
@@ -370,12 +443,26 @@ func (c *Client) RemoveTrackedWalletsV1(ctx context.Context, req *apiv1.RemoveTr
 //
 // Cost: 5 credits per request
 //
+// Example:
+//
+//	wallet, err := client.GetWalletByAddressV1(ctx, "0x1234...")
+//	if errors.Is(err, api.ErrNotFound) {
+//		// the wallet is not tracked yet
+//	}
+//
 // https://developer.cielo.finance/reference/getWalletByAddress
 func (c *Client) GetWalletByAddressV1(ctx context.Context, wallet string) (*apiv1.TrackedWallet, error) {
 	resp := api.CieloResponse[apiv1.TrackedWallet]{}
 
-	path := fmt.Sprintf("/v1/tracked-wallets/address/%s", wallet)
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	path := fmt.Sprintf("/v1/tracked-wallets/address/%s", url.PathEscape(wallet))
+	call := &Call{
+		Endpoint: EndpointGetWalletByAddressV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Credits:  5,
+		args:     walletArgs{method: EndpointGetWalletByAddressV1, wallet: wallet},
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get wallet by address: %w", err)
 	}
 
@@ -391,7 +478,15 @@ func (c *Client) UpdateTrackedWalletV1(ctx context.Context, walletID int64, req
 	resp := api.CieloResponse[apiv1.TrackedWallet]{}
 
 	path := fmt.Sprintf("/v1/tracked-wallets/%d", walletID)
-	if err := c.makeRequest(ctx, http.MethodPut, path, req, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointUpdateTrackedWalletV1,
+		Method:   http.MethodPut,
+		Path:     path,
+		Request:  req,
+		Credits:  5,
+		body:     req,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to update tracked wallet: %w", err)
 	}
 
@@ -416,8 +511,17 @@ func (c *Client) UpdateTrackedWalletV1(ctx context.Context, walletID int64, req
 func (c *Client) UpdateTrackedWalletV2(ctx context.Context, wallet string, req *apiv1.UpdateTrackedWalletV2Request) (*apiv1.TrackedWallet, error) {
 	resp := api.CieloResponse[apiv1.TrackedWallet]{}
 
-	path := fmt.Sprintf("/v2/tracked-wallets/%s", wallet)
-	if err := c.makeRequest(ctx, http.MethodPut, path, req, &resp); err != nil {
+	path := fmt.Sprintf("/v2/tracked-wallets/%s", url.PathEscape(wallet))
+	call := &Call{
+		Endpoint: EndpointUpdateTrackedWalletV2,
+		Method:   http.MethodPut,
+		Path:     path,
+		Request:  req,
+		Credits:  5,
+		body:     req,
+		args:     walletArgs{method: EndpointUpdateTrackedWalletV2, wallet: wallet},
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to update tracked wallet v2: %w", err)
 	}
 
@@ -434,7 +538,13 @@ func (c *Client) GetTelegramBotsV1(ctx context.Context) (*apiv1.GetTelegramBotsR
 	resp := api.CieloResponse[apiv1.GetTelegramBotsResponse]{}
 
 	const path = "/v1/tracked-wallets/telegram-bots"
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointGetTelegramBotsV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Credits:  5,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get telegram bots: %w", err)
 	}
 
@@ -465,8 +575,15 @@ func (c *Client) GetTelegramBotsV1(ctx context.Context) (*apiv1.GetTelegramBotsR
 func (c *Client) GetWalletPortfolioV1(ctx context.Context, wallet string) (*apiv1.WalletPortfolioResponse, error) {
 	resp := api.CieloResponse[apiv1.WalletPortfolioResponse]{}
 
-	path := fmt.Sprintf("/v1/%s/portfolio", wallet)
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	path := fmt.Sprintf("/v1/%s/portfolio", url.PathEscape(wallet))
+	call := &Call{
+		Endpoint: EndpointGetWalletPortfolioV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Credits:  20,
+		args:     walletArgs{method: EndpointGetWalletPortfolioV1, wallet: wallet},
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get wallet portfolio: %w", err)
 	}
 
@@ -504,7 +621,14 @@ func (c *Client) GetWalletPortfolioV2(ctx context.Context, req *apiv1.WalletPort
 	resp := api.CieloResponse[apiv1.WalletPortfolioV2Response]{}
 
 	path := fmt.Sprintf("/v2/portfolio?%s", req.GetQueryString())
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointGetWalletPortfolioV2,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  portfolioV2Credits(req),
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get wallet portfolio v2: %w", err)
 	}
 
@@ -524,7 +648,14 @@ func (c *Client) GetTokenMetadataV1(ctx context.Context, req *apiv1.TokenMetadat
 	resp := api.CieloResponse[apiv1.TokenMetadataResponse]{}
 
 	path := fmt.Sprintf("/v1/token/metadata?%s", req.GetQueryString())
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointGetTokenMetadataV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  1,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get token metadata: %w", err)
 	}
 
@@ -541,7 +672,14 @@ func (c *Client) GetTokenPriceV1(ctx context.Context, req *apiv1.TokenPriceReque
 	resp := api.CieloResponse[apiv1.TokenPriceResponse]{}
 
 	path := fmt.Sprintf("/v1/token/price?%s", req.GetQueryString())
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointGetTokenPriceV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  1,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get token price: %w", err)
 	}
 
@@ -560,7 +698,14 @@ func (c *Client) GetTokenStatsV1(ctx context.Context, req *apiv1.TokenStatsReque
 	resp := api.CieloResponse[apiv1.TokenStatsResponse]{}
 
 	path := fmt.Sprintf("/v1/token/stats?%s", req.GetQueryString())
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointGetTokenStatsV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  3,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get token stats: %w", err)
 	}
 
@@ -577,8 +722,15 @@ func (c *Client) GetTokenStatsV1(ctx context.Context, req *apiv1.TokenStatsReque
 func (c *Client) GetTokenBalanceV1(ctx context.Context, req *apiv1.TokenBalanceRequest) (*apiv1.TokenBalanceResponse, error) {
 	resp := api.CieloResponse[apiv1.TokenBalanceResponse]{}
 
-	path := fmt.Sprintf("/v1/%s/token-balance?%s", req.Wallet, req.GetQueryString())
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	path := fmt.Sprintf("/v1/%s/token-balance?%s", url.PathEscape(req.Wallet), req.GetQueryString())
+	call := &Call{
+		Endpoint: EndpointGetTokenBalanceV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  3,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get token balance: %w", err)
 	}
 
@@ -591,9 +743,10 @@ func (c *Client) GetTokenBalanceV1(ctx context.Context, req *apiv1.TokenBalanceR
 // including PnL, ROI, win rate, and trading behavior insights.
 //
 // Note: This endpoint may return 202 Accepted if data is not ready yet.
-// In that case, retry the request after 10 seconds.
+// The client then polls the endpoint until the stats are ready, see WithTradingStatsPolling.
+// Use StartTradingStatsV1 for a non-blocking variant.
 //
-// Cost: 30 credits per request
+// Cost: 30 credits per request (each poll is a separate request)
 //
 // Example:
 //
@@ -609,15 +762,33 @@ func (c *Client) GetTokenBalanceV1(ctx context.Context, req *apiv1.TokenBalanceR
 //
 // https://developer.cielo.finance/reference/getTradingStats
 func (c *Client) GetTradingStatsV1(ctx context.Context, req *apiv1.TradingStatsRequest) (*apiv1.TradingStatsResponse, error) {
+	pending, err := c.StartTradingStatsV1(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return pending.Wait(ctx)
+}
+
+// fetchTradingStatsV1 performs a single trading stats request.
+// It returns an error matching api.ErrDataNotReady while the stats are being computed.
+func (c *Client) fetchTradingStatsV1(ctx context.Context, req *apiv1.TradingStatsRequest) (*apiv1.TradingStatsResponse, error) {
 	resp := api.CieloResponse[apiv1.TradingStatsResponse]{}
 
-	path := fmt.Sprintf("/v1/%s/trading-stats", req.Wallet)
+	path := fmt.Sprintf("/v1/%s/trading-stats", url.PathEscape(req.Wallet))
 	queryString := req.GetQueryString()
 	if queryString != "" {
 		path = fmt.Sprintf("%s?%s", path, queryString)
 	}
 
-	if err := c.makeRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+	call := &Call{
+		Endpoint: EndpointGetTradingStatsV1,
+		Method:   http.MethodGet,
+		Path:     path,
+		Request:  req,
+		Credits:  30,
+	}
+	if err := c.makeRequest(ctx, call, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get trading stats: %w", err)
 	}
 
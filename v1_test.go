@@ -244,6 +244,38 @@ func TestGetTradingStatsV1(t *testing.T) {
 	assert.Equal(t, 80.0, resp.WinRate)
 }
 
+func TestGetTrackedWalletsV1_Query(t *testing.T) {
+	mockResp := api.CieloResponse[apiv1.GetTrackedWalletsResponse]{
+		Data: apiv1.GetTrackedWalletsResponse{
+			TrackedWallets: []apiv1.TrackedWallet{{Wallet: "0xabc"}},
+		},
+	}
+
+	server := testutil.NewMockServer(t)
+	server.SetResponse("/v1/tracked-wallets?list_id=42&next_object=20", mockResp)
+
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL))
+
+	resp, err := client.GetTrackedWalletsV1(context.Background(), &apiv1.GetTrackedWalletsRequest{
+		ListID:     testutil.Ptr(int64(42)),
+		NextObject: testutil.Ptr("20"),
+	})
+	require.NoError(t, err)
+	assert.Len(t, resp.TrackedWallets, 1)
+}
+
+func TestWalletPathEscaping(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	server.SetResponse("/v1/a/b?c/portfolio", api.CieloResponse[apiv1.WalletPortfolioResponse]{})
+
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL))
+
+	// Unescaped, "?c/portfolio" would be sent as the query string.
+	_, err := client.GetWalletPortfolioV1(context.Background(), "a/b?c")
+	require.NoError(t, err)
+	server.AssertRequestCount(t, "/v1/a/b?c/portfolio", 1)
+}
+
 func TestErrorResponse(t *testing.T) {
 	server := testutil.NewMockServer(t)
 	// Don't set any response - will return 404
@@ -0,0 +1,50 @@
+package cielogo
+
+import "github.com/sealtv/cielogo/api"
+
+// WithoutRequestValidation disables the validation of requests before they are sent.
+// By default a request whose Validate method fails is rejected with an *api.ValidationError
+// without calling the API.
+func WithoutRequestValidation() ClientOption {
+	return func(c *Client) {
+		c.skipValidation = true
+	}
+}
+
+// validator is implemented by every request type of the apiv1 package.
+type validator interface {
+	Validate() error
+}
+
+// walletArgs checks the wallet passed as a bare string to methods such as GetWalletPortfolioV1.
+type walletArgs struct {
+	method string
+	wallet string
+}
+
+// Validate checks that the wallet is set.
+func (a walletArgs) Validate() error {
+	v := api.Validator{}
+	v.Required(a.wallet, "wallet")
+
+	return v.Err(a.method)
+}
+
+// validate runs the Validate method of the call's arguments and request, unless validation is disabled.
+func (c *Client) validate(call *Call) error {
+	if c.skipValidation {
+		return nil
+	}
+
+	if call.args != nil {
+		if err := call.args.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := call.Request.(validator); ok {
+		return v.Validate()
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package cielogo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/sealtv/cielogo/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_RejectsInvalidRequest(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	server.SetResponse("/v1/feed/?limit=500", api.CieloResponse[apiv1.FeedResponse]{})
+
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL))
+
+	_, err := client.GetFeedV1(context.Background(), &apiv1.FeedRequest{Limit: testutil.Ptr(500)})
+	require.ErrorIs(t, err, api.ErrInvalidRequest)
+
+	var validationErr *api.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "FeedRequest", validationErr.Request)
+	require.Len(t, validationErr.Violations, 1)
+	assert.Equal(t, "Limit", validationErr.Violations[0].Field)
+
+	server.AssertRequestCount(t, "/v1/feed/?limit=500", 0)
+}
+
+func TestClient_WithoutRequestValidation(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	server.SetResponse("/v1/feed/?limit=500", api.CieloResponse[apiv1.FeedResponse]{})
+
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL), cielogo.WithoutRequestValidation())
+
+	_, err := client.GetFeedV1(context.Background(), &apiv1.FeedRequest{Limit: testutil.Ptr(500)})
+	require.NoError(t, err)
+
+	server.AssertRequestCount(t, "/v1/feed/?limit=500", 1)
+}
+
+func TestClient_RejectsEmptyWallet(t *testing.T) {
+	server := testutil.NewMockServer(t)
+	client := cielogo.NewClient("test-key", cielogo.WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	tests := map[string]func() error{
+		cielogo.EndpointGetWalletByAddressV1: func() error {
+			_, err := client.GetWalletByAddressV1(ctx, "")
+			return err
+		},
+		cielogo.EndpointUpdateTrackedWalletV2: func() error {
+			_, err := client.UpdateTrackedWalletV2(ctx, " ", &apiv1.UpdateTrackedWalletV2Request{})
+			return err
+		},
+		cielogo.EndpointGetWalletPortfolioV1: func() error {
+			_, err := client.GetWalletPortfolioV1(ctx, "")
+			return err
+		},
+	}
+
+	for endpoint, call := range tests {
+		t.Run(endpoint, func(t *testing.T) {
+			err := call()
+			require.ErrorIs(t, err, api.ErrInvalidRequest)
+
+			var validationErr *api.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, endpoint, validationErr.Request)
+			assert.Equal(t, "wallet", validationErr.Violations[0].Field)
+		})
+	}
+
+	assert.Empty(t, server.RequestCount, "no request must be sent")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"time"
 
@@ -21,24 +22,53 @@ const (
 )
 
 type WebsocketClient struct {
-	conn *websocket.Conn
+	conn       *websocket.Conn
+	logger     *clientLogger
+	metrics    *Metrics
+	tracer     Tracer
+	txDecoding apiv1.TxDecodeOptions
+
+	onDecodeError func(message []byte, err error)
+}
+
+// WithWebsocketURL sets a custom WebSocket URL (useful for testing)
+func WithWebsocketURL(url string) ClientOption {
+	return func(c *Client) {
+		c.websocketURL = url
+	}
 }
 
 func (c *Client) NewWebsocketConnection(ctx context.Context, opts ...WebsocketOption) (*WebsocketClient, error) {
-	conn, resp, err := websocket.DefaultDialer.Dial(wsURL, http.Header{
+	ctx, span := c.tracer.Start(ctx, SpanWebsocketDial, Attribute{Key: "cielo.url", Value: c.websocketURL})
+	defer span.End()
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, c.websocketURL, http.Header{
 		"X-API-KEY": []string{c.apiKey},
 	})
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
 	if err != nil {
+		endSpan(span, err)
+		c.logger.log(ctx, c.logger.levels.Failure, "cielo websocket dial failed",
+			slog.String("url", c.websocketURL),
+			slog.String("error", c.logger.redact(err.Error())),
+		)
+
 		return nil, fmt.Errorf("failed to open websocket connection: %w", err)
 	}
 
+	c.logger.log(ctx, c.logger.levels.Websocket, "cielo websocket connected", slog.String("url", c.websocketURL))
+	c.metrics.observeDial()
+
 	conn.SetReadLimit(maxMessageSize)
 
 	ws := &WebsocketClient{
-		conn: conn,
+		conn:       conn,
+		logger:     &c.logger,
+		metrics:    c.metrics,
+		tracer:     c.tracer,
+		txDecoding: c.txDecoding,
 	}
 
 	for _, opt := range opts {
@@ -53,39 +83,132 @@ func (ws *WebsocketClient) Close() {
 }
 
 func (ws *WebsocketClient) SendCommand(cmd apiv1.WebSocketsCommand) error {
+	return ws.SendCommandContext(context.Background(), cmd)
+}
+
+// SendCommandContext sends a command, tracing it as a child of the span carried by ctx.
+func (ws *WebsocketClient) SendCommandContext(ctx context.Context, cmd apiv1.WebSocketsCommand) error {
+	ctx, span := ws.tracer.Start(ctx, SpanWebsocketSend, Attribute{Key: "cielo.command", Value: string(cmd.GetType())})
+	defer span.End()
+
 	if err := ws.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
-		return fmt.Errorf("cannot set write deadline: %w", err)
+		err = fmt.Errorf("cannot set write deadline: %w", err)
+		endSpan(span, err)
+
+		return err
 	}
 
 	if err := ws.conn.WriteJSON(cmd); err != nil {
-		return fmt.Errorf("cannot write json event: %w", err)
+		err = fmt.Errorf("cannot write json event: %w", err)
+		endSpan(span, err)
+
+		return err
 	}
 
+	ws.logger.log(ctx, ws.logger.levels.Websocket, "cielo websocket command sent",
+		slog.String("command", string(cmd.GetType())),
+	)
+
 	return nil
 }
 
+// RunListener reads events from the connection and sends them to out until the context is done
+// or the connection is closed. Every message is traced.
+//
+// Messages that cannot be decoded, including transactions of unknown types with
+// WithStrictTxTypes, are not sent to out: they are logged, passed to the handler set with
+// WithDecodeErrorHandler, if any, and skipped. RunListener returns nil once the context is done
+// or the connection is closed normally (close codes 1000, 1001 and 1006 included) and an error
+// for any other close code or read failure.
 func (ws *WebsocketClient) RunListener(ctx context.Context, out chan<- apiv1.WSEvent) error {
 	for {
-		var event apiv1.WSEvent
-		err := ws.conn.ReadJSON(&event)
+		_, message, err := ws.conn.ReadMessage()
 		if err != nil {
-			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				return fmt.Errorf("unexpected close error: %w", err)
-			}
-
-			if errors.Is(err, websocket.ErrCloseSent) {
-				return nil
-			}
+			return ws.readError(ctx, err)
 		}
 
-		select {
-		case out <- event:
-		case <-ctx.Done():
+		if !ws.deliver(ctx, message, out) {
 			return nil
 		}
 	}
 }
 
+// deliver decodes a message and sends the event to out within a span.
+// It returns false once the context is done.
+func (ws *WebsocketClient) deliver(ctx context.Context, message []byte, out chan<- apiv1.WSEvent) bool {
+	ctx, span := ws.tracer.Start(ctx, SpanWebsocketEvent, Attribute{Key: "cielo.size", Value: len(message)})
+	defer span.End()
+
+	var event apiv1.WSEvent
+	if err := ws.txDecoding.UnmarshalWSEvent(message, &event); err != nil {
+		endSpan(span, err)
+		ws.logger.log(ctx, ws.logger.levels.Failure, "cielo websocket event decode failed",
+			slog.String("error", err.Error()),
+			slog.Int("size", len(message)),
+		)
+		if ws.onDecodeError != nil {
+			ws.onDecodeError(message, err)
+		}
+
+		return true
+	}
+
+	span.SetAttributes(Attribute{Key: "cielo.event_type", Value: string(event.Type)})
+	if tx, ok := event.Data.(apiv1.TxEvent); ok {
+		span.SetAttributes(Attribute{Key: "cielo.tx_type", Value: string(tx.TxType)})
+	}
+	ws.observeEvent(ctx, &event)
+
+	select {
+	case out <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// readError logs a read error and returns the error RunListener stops with.
+func (ws *WebsocketClient) readError(ctx context.Context, err error) error {
+	var closeErr *websocket.CloseError
+	if errors.As(err, &closeErr) {
+		ws.logger.log(ctx, ws.logger.levels.Websocket, "cielo websocket closed",
+			slog.Int("code", closeErr.Code),
+			slog.String("reason", closeErr.Text),
+		)
+	}
+
+	if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+		return fmt.Errorf("unexpected close error: %w", err)
+	}
+
+	if closeErr != nil || errors.Is(err, websocket.ErrCloseSent) || ctx.Err() != nil {
+		return nil
+	}
+
+	ws.logger.log(ctx, ws.logger.levels.Failure, "cielo websocket read failed", slog.String("error", err.Error()))
+
+	return fmt.Errorf("failed to read websocket message: %w", err)
+}
+
+// observeEvent logs subscription acknowledgements and error events, and counts transaction events.
+func (ws *WebsocketClient) observeEvent(ctx context.Context, event *apiv1.WSEvent) {
+	switch event.Type {
+	case apiv1.WalletSubscribedEventType, apiv1.WalletUnsubscribedEventType,
+		apiv1.FeedSubscribedEventType, apiv1.FeedUnsubscribedEventType:
+		ws.logger.log(ctx, ws.logger.levels.Websocket, "cielo websocket subscription acknowledged",
+			slog.String("type", string(event.Type)),
+		)
+	case apiv1.ErrEventType:
+		ws.logger.log(ctx, ws.logger.levels.Failure, "cielo websocket error event",
+			slog.Any("data", event.Data),
+		)
+	case apiv1.TxEventType:
+		if tx, ok := event.Data.(apiv1.TxEvent); ok {
+			ws.metrics.observeTxEvent(string(tx.TxType))
+		}
+	}
+}
+
 type WebsocketOption func(*WebsocketClient)
 
 func WithCloseHandler(h func(code int, text string) error) WebsocketOption {
@@ -96,6 +219,14 @@ func WithCloseHandler(h func(code int, text string) error) WebsocketOption {
 	}
 }
 
+// WithDecodeErrorHandler sets a function called by RunListener with every message it cannot
+// decode and the decoding error. The message is skipped once the handler returns.
+func WithDecodeErrorHandler(h func(message []byte, err error)) WebsocketOption {
+	return func(ws *WebsocketClient) {
+		ws.onDecodeError = h
+	}
+}
+
 func WithPingHandler(h func(appData string) error) WebsocketOption {
 	return func(ws *WebsocketClient) {
 		ws.conn.SetPingHandler(func(appData string) error {
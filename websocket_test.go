@@ -0,0 +1,45 @@
+package cielogo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sealtv/cielogo"
+	"github.com/sealtv/cielogo/api/apiv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsocketClient_DecodeErrorHandler(t *testing.T) {
+	url := newScriptedWebsocketServer(t,
+		`not json`,
+		`{"type":"tx","data":{"tx_type":"restake","wallet":"0x1"}}`,
+		`{"type":"tx","data":{"tx_type":"swap","wallet":"0x1"}}`,
+	)
+	client := cielogo.NewClient("test-key", cielogo.WithWebsocketURL(url), cielogo.WithStrictTxTypes())
+
+	var failed []string
+	var errs []error
+	ws, err := client.NewWebsocketConnection(context.Background(), cielogo.WithDecodeErrorHandler(func(message []byte, err error) {
+		failed = append(failed, string(message))
+		errs = append(errs, err)
+	}))
+	require.NoError(t, err)
+	defer ws.Close()
+
+	require.NoError(t, ws.SendCommand(&apiv1.FeedSubscribeCmd{}))
+
+	events := make(chan apiv1.WSEvent, 10)
+	require.NoError(t, ws.RunListener(context.Background(), events), "a normal closure is not an error")
+	close(events)
+
+	var received []apiv1.TxType
+	for event := range events {
+		received = append(received, event.Data.(apiv1.TxEvent).TxType) //nolint:errcheck // only tx events are scripted
+	}
+	assert.Equal(t, []apiv1.TxType{apiv1.TxTypeSwap}, received)
+
+	require.Len(t, failed, 2)
+	assert.Equal(t, "not json", failed[0])
+	assert.ErrorIs(t, errs[1], apiv1.ErrUnknownTxType)
+}